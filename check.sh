@@ -21,12 +21,21 @@ function lint() {
     golint -set_exit_status ./...
 }
 
+# the nested modules of v2/contrib replace v2 with the local tree, so they break when it gains a dependency
+CONTRIB_MODULES=$(find v2/contrib -name go.mod -exec dirname {} \;)
+
 function vet() {
     echo  "Running go vet ..."
     (
         cd v2
         go vet ./...
     )
+    for module in $CONTRIB_MODULES; do
+        (
+            cd "$module"
+            go vet ./...
+        )
+    done
 }
 
 function unittest() {
@@ -35,6 +44,12 @@ function unittest() {
         cd v2
         go test -v -race -coverprofile=coverage.txt -covermode=atomic ./...
     )
+    for module in $CONTRIB_MODULES; do
+        (
+            cd "$module"
+            go test -v -race ./...
+        )
+    done
 }
 
 if [[ -z $ACTION ]]; then
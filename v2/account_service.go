@@ -52,6 +52,21 @@ type Balance struct {
 	Locked string `json:"locked"`
 }
 
+// GetBalance get the free and locked amounts of asset in the spot account, as decimal strings.
+// An asset the account never held is not an error, its amounts are "0".
+func (c *Client) GetBalance(ctx context.Context, asset string, opts ...RequestOption) (free, locked string, err error) {
+	account, err := c.NewGetAccountService().Do(ctx, opts...)
+	if err != nil {
+		return "", "", err
+	}
+	for _, b := range account.Balances {
+		if b.Asset == asset {
+			return b.Free, b.Locked, nil
+		}
+	}
+	return "0", "0", nil
+}
+
 // GetAccountSnapshotService all account orders; active, canceled, or filled
 type GetAccountSnapshotService struct {
 	c           *Client
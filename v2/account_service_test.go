@@ -89,6 +89,38 @@ func (s *accountServiceTestSuite) TestGetAccount() {
 	s.assertAccountEqual(e, res)
 }
 
+func (s *accountServiceTestSuite) TestGetBalance() {
+	data := []byte(`{
+		"balances": [
+			{"asset": "BTC", "free": "0.5", "locked": "0.25"},
+			{"asset": "LTC", "free": "12", "locked": "0"}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+
+	r := s.r()
+	free, locked, err := s.client.GetBalance(newContext(), "BTC")
+	r.NoError(err)
+	r.Equal("0.5", free)
+	r.Equal("0.25", locked)
+}
+
+func (s *accountServiceTestSuite) TestGetBalanceUnknownAsset() {
+	data := []byte(`{"balances": [{"asset": "BTC", "free": "0.5", "locked": "0.25"}]}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	r := s.r()
+	free, locked, err := s.client.GetBalance(newContext(), "ETH")
+	r.NoError(err)
+	r.Equal("0", free)
+	r.Equal("0", locked)
+}
+
 func (s *accountServiceTestSuite) assertAccountEqual(e, a *Account) {
 	r := s.r()
 	r.Equal(e.MakerCommission, a.MakerCommission, "MakerCommission")
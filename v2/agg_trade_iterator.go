@@ -0,0 +1,85 @@
+package binance
+
+import (
+	"context"
+	"time"
+)
+
+// aggTradesMaxWindow is the longest startTime to endTime range accepted by the aggTrades endpoint, in ms
+const aggTradesMaxWindow = int64(time.Hour / time.Millisecond)
+
+// AggTradesIterator page through the aggregate trades of an AggTradesService.
+// With a time range, the range is walked one hour window at a time until the first trade is found,
+// then the following pages are requested by fromId until endTime.
+type AggTradesIterator struct {
+	s      *AggTradesService
+	fromID *int64
+	timed  bool
+	start  int64
+	end    int64
+	done   bool
+}
+
+// Iterator init an iterator over the aggregate trades matching the service params,
+// endTime defaults to now when only startTime is set
+func (s *AggTradesService) Iterator() *AggTradesIterator {
+	it := &AggTradesIterator{s: s, fromID: s.fromID}
+	if s.startTime != nil || s.endTime != nil {
+		it.timed = true
+		it.end = time.Now().UnixNano() / int64(time.Millisecond)
+		if s.endTime != nil {
+			it.end = *s.endTime
+		}
+		it.start = it.end - aggTradesMaxWindow + 1
+		if s.startTime != nil {
+			it.start = *s.startTime
+		}
+	}
+	return it
+}
+
+// Done tell if all the trades have been returned
+func (it *AggTradesIterator) Done() bool {
+	return it.done
+}
+
+// Next return the next page of trades, it may be empty while Done is false
+func (it *AggTradesIterator) Next(ctx context.Context, opts ...RequestOption) (res []*AggTrade, err error) {
+	if it.done {
+		return []*AggTrade{}, nil
+	}
+	s := &AggTradesService{c: it.s.c, symbol: it.s.symbol, limit: it.s.limit}
+	windowEnd := it.end
+	if it.fromID != nil {
+		s.FromID(*it.fromID)
+	} else if it.timed {
+		if windowEnd > it.start+aggTradesMaxWindow-1 {
+			windowEnd = it.start + aggTradesMaxWindow - 1
+		}
+		s.StartTime(it.start).EndTime(windowEnd)
+	}
+	res, err = s.Do(ctx, opts...)
+	if err != nil {
+		return []*AggTrade{}, err
+	}
+	if it.timed {
+		for i, t := range res {
+			if t.Timestamp > it.end {
+				res = res[:i]
+				it.done = true
+				break
+			}
+		}
+	}
+	if len(res) == 0 {
+		if it.timed && it.fromID == nil && windowEnd < it.end {
+			it.start = windowEnd + 1
+		} else {
+			it.done = true
+		}
+		return res, nil
+	}
+	next := res[len(res)-1].AggTradeID + 1
+	it.fromID = &next
+	return res, nil
+}
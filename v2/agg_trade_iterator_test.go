@@ -0,0 +1,106 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newAggTradesServer serve the trades like the aggTrades endpoint
+func newAggTradesServer(t *testing.T, trades []*AggTrade) (*httptest.Server, *[]string) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		queries = append(queries, q.Encode())
+		limit := 500
+		if l := q.Get("limit"); l != "" {
+			limit, _ = strconv.Atoi(l)
+		}
+		res := []*AggTrade{}
+		for _, trade := range trades {
+			if len(res) == limit {
+				break
+			}
+			if v := q.Get("fromId"); v != "" {
+				if id, _ := strconv.ParseInt(v, 10, 64); trade.AggTradeID < id {
+					continue
+				}
+			}
+			if v := q.Get("startTime"); v != "" {
+				start, _ := strconv.ParseInt(v, 10, 64)
+				end, _ := strconv.ParseInt(q.Get("endTime"), 10, 64)
+				require.Less(t, end-start, aggTradesMaxWindow)
+				if trade.Timestamp < start || trade.Timestamp > end {
+					continue
+				}
+			}
+			res = append(res, trade)
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	return server, &queries
+}
+
+func TestAggTradesIterator(t *testing.T) {
+	r := require.New(t)
+	hour := aggTradesMaxWindow
+	trades := []*AggTrade{}
+	// nothing in the first two hours of the range
+	for i, ts := range []int64{0, 2*hour + 5, 2*hour + 6, 2*hour + 7, 3*hour + 1, 5 * hour, 6 * hour} {
+		trades = append(trades, &AggTrade{AggTradeID: int64(100 + i), Timestamp: ts})
+	}
+	server, queries := newAggTradesServer(t, trades)
+	defer server.Close()
+	c := NewClient("", "")
+	c.BaseURL = server.URL
+
+	it := c.NewAggTradesService().Symbol("BTCUSDT").StartTime(1).EndTime(5 * hour).Limit(2).Iterator()
+	var ids []int64
+	for !it.Done() {
+		page, err := it.Next(context.Background())
+		r.NoError(err)
+		for _, trade := range page {
+			ids = append(ids, trade.AggTradeID)
+		}
+	}
+	r.Equal([]int64{101, 102, 103, 104, 105}, ids)
+	r.Equal([]string{
+		"endTime=3600000&limit=2&startTime=1&symbol=BTCUSDT",
+		"endTime=7200000&limit=2&startTime=3600001&symbol=BTCUSDT",
+		"endTime=10800000&limit=2&startTime=7200001&symbol=BTCUSDT",
+		"fromId=103&limit=2&symbol=BTCUSDT",
+		"fromId=105&limit=2&symbol=BTCUSDT",
+	}, *queries)
+
+	page, err := it.Next(context.Background())
+	r.NoError(err)
+	r.Empty(page)
+}
+
+func TestAggTradesIteratorFromID(t *testing.T) {
+	r := require.New(t)
+	trades := []*AggTrade{}
+	for i := int64(1); i <= 5; i++ {
+		trades = append(trades, &AggTrade{AggTradeID: i, Timestamp: i})
+	}
+	server, queries := newAggTradesServer(t, trades)
+	defer server.Close()
+	c := NewClient("", "")
+	c.BaseURL = server.URL
+
+	it := c.NewAggTradesService().Symbol("BTCUSDT").FromID(2).Limit(3).Iterator()
+	var ids []int64
+	for !it.Done() {
+		page, err := it.Next(context.Background())
+		r.NoError(err)
+		for _, trade := range page {
+			ids = append(ids, trade.AggTradeID)
+		}
+	}
+	r.Equal([]int64{2, 3, 4, 5}, ids)
+	r.Len(*queries, 3)
+}
@@ -0,0 +1,331 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetBlvtInfoService get the info of the leveraged tokens
+// See https://binance-docs.github.io/apidocs/spot/en/#get-blvt-info-market_data
+type GetBlvtInfoService struct {
+	c         *Client
+	tokenName *string
+}
+
+// TokenName set tokenName, e.g. BTCDOWN, all the tokens are returned when it is not set
+func (s *GetBlvtInfoService) TokenName(tokenName string) *GetBlvtInfoService {
+	s.tokenName = &tokenName
+	return s
+}
+
+// Do send request
+func (s *GetBlvtInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*BlvtInfo, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/blvt/tokenInfo",
+		secType:  secTypeAPIKey,
+	}
+	if s.tokenName != nil {
+		r.setParam("tokenName", *s.tokenName)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*BlvtInfo{}, err
+	}
+	res = make([]*BlvtInfo, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BlvtInfo{}, err
+	}
+	return res, nil
+}
+
+// BlvtInfo define the info of a leveraged token
+type BlvtInfo struct {
+	TokenName          string        `json:"tokenName"`
+	Description        string        `json:"description"`
+	Underlying         string        `json:"underlying"`
+	TokenIssued        string        `json:"tokenIssued"`
+	Basket             string        `json:"basket"`
+	CurrentBaskets     []*BlvtBasket `json:"currentBaskets"`
+	Nav                string        `json:"nav"`
+	RealLeverage       string        `json:"realLeverage"`
+	FundingRate        string        `json:"fundingRate"`
+	DailyManagementFee string        `json:"dailyManagementFee"`
+	PurchaseFeePct     string        `json:"purchaseFeePct"`
+	DailyPurchaseLimit string        `json:"dailyPurchaseLimit"`
+	RedeemFeePct       string        `json:"redeemFeePct"`
+	DailyRedeemLimit   string        `json:"dailyRedeemLimit"`
+	Timestamp          int64         `json:"timestamp"`
+}
+
+// BlvtBasket define a futures position held by a leveraged token
+type BlvtBasket struct {
+	Symbol        string `json:"symbol"`
+	Amount        string `json:"amount"`
+	NotionalValue string `json:"notionalValue"`
+}
+
+// SubscribeBlvtService subscribe a leveraged token
+// See https://binance-docs.github.io/apidocs/spot/en/#subscribe-blvt-user_data
+type SubscribeBlvtService struct {
+	c         *Client
+	tokenName string
+	cost      string
+}
+
+// TokenName set tokenName
+func (s *SubscribeBlvtService) TokenName(tokenName string) *SubscribeBlvtService {
+	s.tokenName = tokenName
+	return s
+}
+
+// Cost set cost, the amount of USDT spent
+func (s *SubscribeBlvtService) Cost(cost string) *SubscribeBlvtService {
+	s.cost = cost
+	return s
+}
+
+// Do send request
+func (s *SubscribeBlvtService) Do(ctx context.Context, opts ...RequestOption) (res *BlvtSubscribeResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/blvt/subscribe",
+		secType:  secTypeSigned,
+	}
+	r.setParam("tokenName", s.tokenName)
+	r.setParam("cost", s.cost)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(BlvtSubscribeResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BlvtSubscribeResponse define the response of a leveraged token subscription
+type BlvtSubscribeResponse struct {
+	ID        int64  `json:"id"`
+	Status    string `json:"status"`
+	TokenName string `json:"tokenName"`
+	Amount    string `json:"amount"`
+	Cost      string `json:"cost"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// RedeemBlvtService redeem a leveraged token
+// See https://binance-docs.github.io/apidocs/spot/en/#redeem-blvt-user_data
+type RedeemBlvtService struct {
+	c         *Client
+	tokenName string
+	amount    string
+}
+
+// TokenName set tokenName
+func (s *RedeemBlvtService) TokenName(tokenName string) *RedeemBlvtService {
+	s.tokenName = tokenName
+	return s
+}
+
+// Amount set amount, the amount of tokens redeemed
+func (s *RedeemBlvtService) Amount(amount string) *RedeemBlvtService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *RedeemBlvtService) Do(ctx context.Context, opts ...RequestOption) (res *BlvtRedeemResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/blvt/redeem",
+		secType:  secTypeSigned,
+	}
+	r.setParam("tokenName", s.tokenName)
+	r.setParam("amount", s.amount)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(BlvtRedeemResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BlvtRedeemResponse define the response of a leveraged token redemption
+type BlvtRedeemResponse struct {
+	ID           int64  `json:"id"`
+	Status       string `json:"status"`
+	TokenName    string `json:"tokenName"`
+	RedeemAmount string `json:"redeemAmount"`
+	Amount       string `json:"amount"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+// GetBlvtSubscriptionRecordService list the leveraged token subscriptions
+// See https://binance-docs.github.io/apidocs/spot/en/#query-subscription-record-user_data
+type GetBlvtSubscriptionRecordService struct {
+	c         *Client
+	tokenName *string
+	id        *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// TokenName set tokenName
+func (s *GetBlvtSubscriptionRecordService) TokenName(tokenName string) *GetBlvtSubscriptionRecordService {
+	s.tokenName = &tokenName
+	return s
+}
+
+// ID set id
+func (s *GetBlvtSubscriptionRecordService) ID(id int64) *GetBlvtSubscriptionRecordService {
+	s.id = &id
+	return s
+}
+
+// StartTime set startTime
+func (s *GetBlvtSubscriptionRecordService) StartTime(startTime int64) *GetBlvtSubscriptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetBlvtSubscriptionRecordService) EndTime(endTime int64) *GetBlvtSubscriptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default 1000 and max 1000
+func (s *GetBlvtSubscriptionRecordService) Limit(limit int) *GetBlvtSubscriptionRecordService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetBlvtSubscriptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res []*BlvtSubscriptionRecord, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/blvt/subscribe/record",
+		secType:  secTypeSigned,
+	}
+	setBlvtRecordParams(r, s.tokenName, s.id, s.startTime, s.endTime, s.limit)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*BlvtSubscriptionRecord{}, err
+	}
+	res = make([]*BlvtSubscriptionRecord, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BlvtSubscriptionRecord{}, err
+	}
+	return res, nil
+}
+
+// BlvtSubscriptionRecord define a leveraged token subscription
+type BlvtSubscriptionRecord struct {
+	ID          int64  `json:"id"`
+	TokenName   string `json:"tokenName"`
+	Amount      string `json:"amount"`
+	Nav         string `json:"nav"`
+	Fee         string `json:"fee"`
+	TotalCharge string `json:"totalCharge"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// GetBlvtRedemptionRecordService list the leveraged token redemptions
+// See https://binance-docs.github.io/apidocs/spot/en/#query-redemption-record-user_data
+type GetBlvtRedemptionRecordService struct {
+	c         *Client
+	tokenName *string
+	id        *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// TokenName set tokenName
+func (s *GetBlvtRedemptionRecordService) TokenName(tokenName string) *GetBlvtRedemptionRecordService {
+	s.tokenName = &tokenName
+	return s
+}
+
+// ID set id
+func (s *GetBlvtRedemptionRecordService) ID(id int64) *GetBlvtRedemptionRecordService {
+	s.id = &id
+	return s
+}
+
+// StartTime set startTime
+func (s *GetBlvtRedemptionRecordService) StartTime(startTime int64) *GetBlvtRedemptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetBlvtRedemptionRecordService) EndTime(endTime int64) *GetBlvtRedemptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default 1000 and max 1000
+func (s *GetBlvtRedemptionRecordService) Limit(limit int) *GetBlvtRedemptionRecordService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetBlvtRedemptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res []*BlvtRedemptionRecord, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/blvt/redeem/record",
+		secType:  secTypeSigned,
+	}
+	setBlvtRecordParams(r, s.tokenName, s.id, s.startTime, s.endTime, s.limit)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*BlvtRedemptionRecord{}, err
+	}
+	res = make([]*BlvtRedemptionRecord, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BlvtRedemptionRecord{}, err
+	}
+	return res, nil
+}
+
+// BlvtRedemptionRecord define a leveraged token redemption
+type BlvtRedemptionRecord struct {
+	ID         int64  `json:"id"`
+	TokenName  string `json:"tokenName"`
+	Amount     string `json:"amount"`
+	Nav        string `json:"nav"`
+	Fee        string `json:"fee"`
+	NetProceed string `json:"netProceed"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+func setBlvtRecordParams(r *request, tokenName *string, id, startTime, endTime *int64, limit *int) {
+	if tokenName != nil {
+		r.setParam("tokenName", *tokenName)
+	}
+	if id != nil {
+		r.setParam("id", *id)
+	}
+	if startTime != nil {
+		r.setParam("startTime", *startTime)
+	}
+	if endTime != nil {
+		r.setParam("endTime", *endTime)
+	}
+	if limit != nil {
+		r.setParam("limit", *limit)
+	}
+}
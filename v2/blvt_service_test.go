@@ -0,0 +1,218 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type blvtServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBlvtService(t *testing.T) {
+	suite.Run(t, new(blvtServiceTestSuite))
+}
+
+func (s *blvtServiceTestSuite) TestGetBlvtInfo() {
+	data := []byte(`[
+		{
+			"tokenName": "BTCDOWN",
+			"description": "3X Short Bitcoin Token",
+			"underlying": "BTC",
+			"tokenIssued": "717953.95",
+			"basket": "-821.474 BTCUSDT Futures",
+			"currentBaskets": [
+				{
+					"symbol": "BTCUSDT",
+					"amount": "-1183.984",
+					"notionalValue": "-22871462.14857"
+				}
+			],
+			"nav": "4.79",
+			"realLeverage": "4.397",
+			"fundingRate": "0.0011",
+			"dailyManagementFee": "0.0001",
+			"purchaseFeePct": "0.0010",
+			"dailyPurchaseLimit": "100000",
+			"redeemFeePct": "0.0010",
+			"dailyRedeemLimit": "1000000",
+			"timestamp": 1583127900000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("tokenName", "BTCDOWN")
+		s.assertRequestEqual(e, r)
+	})
+
+	infos, err := s.client.NewGetBlvtInfoService().TokenName("BTCDOWN").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(infos, 1)
+	r.Equal(&BlvtInfo{
+		TokenName:   "BTCDOWN",
+		Description: "3X Short Bitcoin Token",
+		Underlying:  "BTC",
+		TokenIssued: "717953.95",
+		Basket:      "-821.474 BTCUSDT Futures",
+		CurrentBaskets: []*BlvtBasket{
+			{Symbol: "BTCUSDT", Amount: "-1183.984", NotionalValue: "-22871462.14857"},
+		},
+		Nav:                "4.79",
+		RealLeverage:       "4.397",
+		FundingRate:        "0.0011",
+		DailyManagementFee: "0.0001",
+		PurchaseFeePct:     "0.0010",
+		DailyPurchaseLimit: "100000",
+		RedeemFeePct:       "0.0010",
+		DailyRedeemLimit:   "1000000",
+		Timestamp:          1583127900000,
+	}, infos[0])
+}
+
+func (s *blvtServiceTestSuite) TestSubscribeBlvt() {
+	data := []byte(`{
+		"id": 123,
+		"status": "S",
+		"tokenName": "LINKUP",
+		"amount": "0.95590905",
+		"cost": "9.99999995",
+		"timestamp": 1600249972899
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"tokenName": "LINKUP",
+			"cost":      "10",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSubscribeBlvtService().TokenName("LINKUP").Cost("10").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&BlvtSubscribeResponse{
+		ID:        123,
+		Status:    "S",
+		TokenName: "LINKUP",
+		Amount:    "0.95590905",
+		Cost:      "9.99999995",
+		Timestamp: 1600249972899,
+	}, res)
+}
+
+func (s *blvtServiceTestSuite) TestRedeemBlvt() {
+	data := []byte(`{
+		"id": 12,
+		"status": "S",
+		"tokenName": "LINKUP",
+		"redeemAmount": "0.95590905",
+		"amount": "10.05022099",
+		"timestamp": 1600250279614
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"tokenName": "LINKUP",
+			"amount":    "0.95590905",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewRedeemBlvtService().TokenName("LINKUP").Amount("0.95590905").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&BlvtRedeemResponse{
+		ID:           12,
+		Status:       "S",
+		TokenName:    "LINKUP",
+		RedeemAmount: "0.95590905",
+		Amount:       "10.05022099",
+		Timestamp:    1600250279614,
+	}, res)
+}
+
+func (s *blvtServiceTestSuite) TestGetBlvtSubscriptionRecord() {
+	data := []byte(`[
+		{
+			"id": 1,
+			"tokenName": "LINKUP",
+			"amount": "0.54216292",
+			"nav": "18.42621386",
+			"fee": "0.00999000",
+			"totalCharge": "9.99999991",
+			"timestamp": 1599127217916
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"tokenName": "LINKUP",
+			"startTime": int64(1599127217000),
+			"endTime":   int64(1599127218000),
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	records, err := s.client.NewGetBlvtSubscriptionRecordService().TokenName("LINKUP").
+		StartTime(1599127217000).EndTime(1599127218000).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(records, 1)
+	r.Equal(&BlvtSubscriptionRecord{
+		ID:          1,
+		TokenName:   "LINKUP",
+		Amount:      "0.54216292",
+		Nav:         "18.42621386",
+		Fee:         "0.00999000",
+		TotalCharge: "9.99999991",
+		Timestamp:   1599127217916,
+	}, records[0])
+}
+
+func (s *blvtServiceTestSuite) TestGetBlvtRedemptionRecord() {
+	data := []byte(`[
+		{
+			"id": 1,
+			"tokenName": "LINKUP",
+			"amount": "0.54216292",
+			"nav": "18.36345064",
+			"fee": "0.00995598",
+			"netProceed": "9.94602604",
+			"timestamp": 1599128003050
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"id": int64(1),
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	records, err := s.client.NewGetBlvtRedemptionRecordService().ID(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(records, 1)
+	r.Equal(&BlvtRedemptionRecord{
+		ID:         1,
+		TokenName:  "LINKUP",
+		Amount:     "0.54216292",
+		Nav:        "18.36345064",
+		Fee:        "0.00995598",
+		NetProceed: "9.94602604",
+		Timestamp:  1599128003050,
+	}, records[0])
+}
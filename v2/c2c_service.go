@@ -2,6 +2,7 @@ package binance
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
@@ -15,31 +16,41 @@ type C2CTradeHistoryService struct {
 	rows           *int32
 }
 
-// TransactionType set transaction type
+// GetC2CTradeHistoryService retrieve c2c trade history, see C2CTradeHistoryService
+type GetC2CTradeHistoryService = C2CTradeHistoryService
+
+// TradeType set tradeType, SideTypeBuy or SideTypeSell
 func (s *C2CTradeHistoryService) TradeType(tradeType SideType) *C2CTradeHistoryService {
 	s.tradeType = tradeType
 	return s
 }
 
-// BeginTime set beginTime
+// StartTimestamp set startTimestamp
 func (s *C2CTradeHistoryService) StartTimestamp(startTimestamp int64) *C2CTradeHistoryService {
 	s.startTimestamp = &startTimestamp
 	return s
 }
 
-// EndTime set endTime
-func (s *C2CTradeHistoryService) EndTime(endTimestamp int64) *C2CTradeHistoryService {
+// EndTimestamp set endTimestamp
+func (s *C2CTradeHistoryService) EndTimestamp(endTimestamp int64) *C2CTradeHistoryService {
 	s.endTimestamp = &endTimestamp
 	return s
 }
 
+// EndTime set endTimestamp
+//
+// Deprecated: use EndTimestamp instead
+func (s *C2CTradeHistoryService) EndTime(endTimestamp int64) *C2CTradeHistoryService {
+	return s.EndTimestamp(endTimestamp)
+}
+
 // Page set page
 func (s *C2CTradeHistoryService) Page(page int32) *C2CTradeHistoryService {
 	s.page = &page
 	return s
 }
 
-// Rows set rows
+// Rows set rows, default 100 and max 100
 func (s *C2CTradeHistoryService) Rows(rows int32) *C2CTradeHistoryService {
 	s.rows = &rows
 	return s
@@ -47,6 +58,9 @@ func (s *C2CTradeHistoryService) Rows(rows int32) *C2CTradeHistoryService {
 
 // Do send request
 func (s *C2CTradeHistoryService) Do(ctx context.Context, opts ...RequestOption) (*C2CTradeHistory, error) {
+	if s.tradeType != SideTypeBuy && s.tradeType != SideTypeSell {
+		return nil, fmt.Errorf("%w: invalid tradeType %q", ErrInvalidParam, s.tradeType)
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/sapi/v1/c2c/orderMatch/listUserOrderHistory",
@@ -57,7 +71,7 @@ func (s *C2CTradeHistoryService) Do(ctx context.Context, opts ...RequestOption)
 		r.setParam("startTimestamp", *s.startTimestamp)
 	}
 	if s.endTimestamp != nil {
-		r.setParam("endTime", *s.endTimestamp)
+		r.setParam("endTimestamp", *s.endTimestamp)
 	}
 	if s.page != nil {
 		r.setParam("page", *s.page)
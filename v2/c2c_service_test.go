@@ -45,13 +45,21 @@ func (s *c2cServiceTestSuite) TestC2CTradeHistory() {
 	tradeType := SideTypeSell
 	s.assertReq(func(r *request) {
 		e := newSignedRequest().setParams(params{
-			"tradeType": tradeType,
+			"tradeType":      tradeType,
+			"startTimestamp": int64(1619361369000),
+			"endTimestamp":   int64(1619361370000),
+			"page":           int32(1),
+			"rows":           int32(100),
 		})
 		s.assertRequestEqual(e, r)
 	})
 
 	res, err := s.client.NewC2CTradeHistoryService().
 		TradeType(tradeType).
+		StartTimestamp(1619361369000).
+		EndTimestamp(1619361370000).
+		Page(1).
+		Rows(100).
 		Do(newContext())
 	s.r().NoError(err)
 	e := &C2CTradeHistory{
@@ -111,3 +119,8 @@ func (s *c2cServiceTestSuite) assertC2CTradeHistoryRecordEqual(e, a *C2CRecord)
 	r.Equal(e.CounterPartNickName, a.CounterPartNickName, "CounterPartNickName")
 	r.Equal(e.AdvertisementRole, a.AdvertisementRole, "AdvertisementRole")
 }
+
+func (s *c2cServiceTestSuite) TestC2CTradeHistoryInvalidTradeType() {
+	_, err := s.client.NewC2CTradeHistoryService().Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
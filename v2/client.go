@@ -7,11 +7,13 @@ import (
 	"crypto/sha256"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/bitly/go-simplejson"
@@ -101,6 +103,12 @@ type RateLimitInterval string
 // AccountType define the account types
 type AccountType string
 
+// STPMode define the self trade prevention mode of an order
+type STPMode string
+
+// NFTOrderType define the type of an NFT transaction
+type NFTOrderType int
+
 // Endpoints
 const (
 	baseAPIMainURL    = "https://api.binance.com"
@@ -126,6 +134,11 @@ const (
 	OrderTypeTakeProfit      OrderType = "TAKE_PROFIT"
 	OrderTypeTakeProfitLimit OrderType = "TAKE_PROFIT_LIMIT"
 
+	STPModeExpireTaker STPMode = "EXPIRE_TAKER"
+	STPModeExpireMaker STPMode = "EXPIRE_MAKER"
+	STPModeExpireBoth  STPMode = "EXPIRE_BOTH"
+	STPModeNone        STPMode = "NONE"
+
 	TimeInForceTypeGTC TimeInForceType = "GTC"
 	TimeInForceTypeIOC TimeInForceType = "IOC"
 	TimeInForceTypeFOK TimeInForceType = "FOK"
@@ -165,7 +178,10 @@ const (
 	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
 	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
 	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
-	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
+	UserDataEventTypeListStatus              UserDataEventType = "listStatus"
+	UserDataEventTypeListenKeyExpired        UserDataEventType = "listenKeyExpired"
+	UserDataEventTypeEventStreamTerminated   UserDataEventType = "eventStreamTerminated"
+	UserDataEventTypeExternalLockUpdate      UserDataEventType = "externalLockUpdate"
 
 	MarginTransferTypeToMargin MarginTransferType = 1
 	MarginTransferTypeToMain   MarginTransferType = 2
@@ -236,6 +252,12 @@ const (
 	AccountTypeIsolatedMargin AccountType = "ISOLATED_MARGIN"
 	AccountTypeUSDTFuture     AccountType = "USDT_FUTURE"
 	AccountTypeCoinFuture     AccountType = "COIN_FUTURE"
+
+	NFTOrderTypePurchase      NFTOrderType = 0
+	NFTOrderTypeSale          NFTOrderType = 1
+	NFTOrderTypeRoyaltyIncome NFTOrderType = 2
+	NFTOrderTypePrimaryMarket NFTOrderType = 3
+	NFTOrderTypeMintFee       NFTOrderType = 4
 )
 
 func currentTimestamp() int64 {
@@ -266,8 +288,8 @@ func getAPIEndpoint() string {
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
-func NewClient(apiKey, secretKey string) *Client {
-	return &Client{
+func NewClient(apiKey, secretKey string, opts ...ClientOption) *Client {
+	c := &Client{
 		APIKey:     apiKey,
 		SecretKey:  secretKey,
 		BaseURL:    getAPIEndpoint(),
@@ -275,10 +297,11 @@ func NewClient(apiKey, secretKey string) *Client {
 		HTTPClient: http.DefaultClient,
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
+	return c.applyOptions(opts)
 }
 
 // NewProxiedClient passing a proxy url
-func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
+func NewProxiedClient(apiKey, secretKey, proxyUrl string, opts ...ClientOption) *Client {
 	proxy, err := url.Parse(proxyUrl)
 	if err != nil {
 		log.Fatal(err)
@@ -287,7 +310,7 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		Proxy:           http.ProxyURL(proxy),
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 	}
-	return &Client{
+	c := &Client{
 		APIKey:    apiKey,
 		SecretKey: secretKey,
 		BaseURL:   getAPIEndpoint(),
@@ -297,6 +320,7 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		},
 		Logger: log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
+	return c.applyOptions(opts)
 }
 
 // NewFuturesClient initialize client for futures API
@@ -320,14 +344,70 @@ type Client struct {
 	HTTPClient *http.Client
 	Debug      bool
 	Logger     *log.Logger
-	TimeOffset int64
-	do         doFunc
+	// StructuredLogger receives the request logs with secrets redacted,
+	// Logger is used in debug mode when it is not set
+	StructuredLogger common.Logger
+	// RequestHook is called around every request, for tracing and metrics
+	RequestHook common.RequestHook
+	TimeOffset  int64
+	do          doFunc
+
+	debugWriter    io.Writer
+	debugBodyLimit int
+	debugOut       *common.DebugWriter
+	failover       hostFailover
+	usedWeight     weightTracker
+	middlewares    []Middleware
+
+	orderStatusConcurrency int
+}
+
+// ClientOption define option type for client
+type ClientOption func(*Client)
+
+// WithDebug write each raw request url and body and each response body to w, prefixed with a timestamp.
+// Signatures and listen keys are redacted and bodies are truncated, see WithDebugBodyLimit.
+func WithDebug(w io.Writer) ClientOption {
+	return func(c *Client) {
+		c.debugWriter = w
+	}
+}
+
+// WithDebugBodyLimit set the number of body bytes written by WithDebug, common.DefaultDebugBodyLimit by default
+func WithDebugBodyLimit(limit int) ClientOption {
+	return func(c *Client) {
+		c.debugBodyLimit = limit
+	}
+}
+
+func (c *Client) applyOptions(opts []ClientOption) *Client {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debugWriter != nil {
+		c.debugOut = common.NewDebugWriter(c.debugWriter, c.debugBodyLimit)
+	}
+	return c
 }
 
-func (c *Client) debug(format string, v ...interface{}) {
-	if c.Debug {
-		c.Logger.Printf(format, v...)
+// logger return the structured logger of the client
+func (c *Client) logger() common.Logger {
+	if c.StructuredLogger != nil {
+		return c.StructuredLogger
 	}
+	if c.Debug && c.Logger != nil {
+		return common.NewStdLogger(c.Logger)
+	}
+	return common.NopLogger
+}
+
+func (c *Client) debug(msg string, keyvals ...interface{}) {
+	c.logger().Debug(msg, keyvals...)
+}
+
+// debugEnabled report whether debug lines are logged, callers skip building costly values otherwise
+func (c *Client) debugEnabled() bool {
+	return c.logger() != common.NopLogger
 }
 
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
@@ -380,7 +460,9 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
+	if c.debugEnabled() {
+		c.debug("parsed request", "url", common.Redact(fullURL), "body", common.Redact(bodyString))
+	}
 
 	r.fullURL = fullURL
 	r.header = header
@@ -389,28 +471,94 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	data, _, err = c.chain(func(ctx context.Context, _ Request) ([]byte, http.Header, error) {
+		return c.doAPI(ctx, r, opts...)
+	})(ctx, r)
+	return data, err
+}
+
+// doAPI send a request, failing over to the backup hosts, and return the response body and header
+func (c *Client) doAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header http.Header, err error) {
+	var res *http.Response
+	if c.RequestHook != nil {
+		info := &common.RequestInfo{Method: r.method, Endpoint: r.endpoint, Start: time.Now()}
+		ctx = c.RequestHook.OnRequestStart(ctx, info)
+		defer func() {
+			out := &common.ResponseInfo{Duration: time.Since(info.Start), Err: err}
+			if res != nil {
+				out.StatusCode = res.StatusCode
+				out.UsedWeight = common.UsedWeight(res.Header)
+			}
+			c.RequestHook.OnRequestEnd(ctx, info, out)
+		}()
+	}
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, nil, err
+	}
+	hosts := c.hosts(r)
+	path := strings.TrimPrefix(r.fullURL, c.BaseURL)
+	body := r.form.Encode()
+	weight := c.EstimateWeight(r.method, r.endpoint, r.query)
+	for i, host := range hosts {
+		c.usedWeight.add(time.Now(), weight)
+		res, data, err = c.send(ctx, r, host+path, body)
+		if err == nil {
+			c.usedWeight.update(time.Now(), res.Header)
+		}
+		status := 0
+		if err == nil {
+			status = res.StatusCode
+		}
+		if i+1 == len(hosts) || ctx.Err() != nil || !canFailOver(r.method, status, err) {
+			if err == nil {
+				c.setLastHost(host)
+			}
+			break
+		}
+		cause := err
+		if cause == nil {
+			cause = fmt.Errorf("status code %d", res.StatusCode)
+		}
+		c.failOver(host, hosts[i+1], cause)
+	}
+	if err != nil {
+		return []byte{}, nil, err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		apiErr := new(common.APIError)
+		e := json.Unmarshal(data, apiErr)
+		if e != nil {
+			c.debug("failed to unmarshal json", "error", e)
+		}
+		c.logger().Error("api error", "method", r.method, "url", common.Redact(r.fullURL), "status", res.StatusCode, "code", apiErr.Code, "msg", apiErr.Message)
+		return nil, res.Header, apiErr
 	}
-	req, err := http.NewRequest(r.method, r.fullURL, r.body)
+	return data, res.Header, nil
+}
+
+// send do a parsed request against fullURL and read the response body
+func (c *Client) send(ctx context.Context, r *request, fullURL string, body string) (res *http.Response, data []byte, err error) {
+	req, err := http.NewRequest(r.method, fullURL, bytes.NewBufferString(body))
 	if err != nil {
-		return []byte{}, err
+		return nil, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v", req)
+	if c.debugOut != nil {
+		c.debugOut.Request(r.method, fullURL, body)
+	}
+	if c.debugEnabled() {
+		c.debug("request", "method", req.Method, "url", common.Redact(fullURL), "header", common.RedactHeader(req.Header))
+	}
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
-	res, err := f(req)
+	res, err = f(req)
 	if err != nil {
-		return []byte{}, err
-	}
-	data, err = ioutil.ReadAll(res.Body)
-	if err != nil {
-		return []byte{}, err
+		c.logger().Error("request failed", "method", req.Method, "url", common.Redact(fullURL), "error", err)
+		return nil, nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -420,19 +568,17 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
-
-	if res.StatusCode >= http.StatusBadRequest {
-		apiErr := new(common.APIError)
-		e := json.Unmarshal(data, apiErr)
-		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
-		}
-		return nil, apiErr
+	data, err = ioutil.ReadAll(res.Body)
+	if err != nil {
+		return res, nil, err
+	}
+	if c.debugOut != nil {
+		c.debugOut.Response(res.StatusCode, fullURL, data)
 	}
-	return data, nil
+	if c.debugEnabled() {
+		c.debug("response", "url", common.Redact(fullURL), "status", res.StatusCode, "header", res.Header, "body", common.Redact(string(data)))
+	}
+	return res, data, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -461,6 +607,11 @@ func (c *Client) NewDepthService() *DepthService {
 	return &DepthService{c: c}
 }
 
+// NewGetOrderBookService init order book service
+func (c *Client) NewGetOrderBookService() *GetOrderBookService {
+	return &GetOrderBookService{c: c}
+}
+
 // NewAggTradesService init aggregate trades service
 func (c *Client) NewAggTradesService() *AggTradesService {
 	return &AggTradesService{c: c}
@@ -521,6 +672,11 @@ func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
 }
 
+// NewAmendOrderKeepPriorityService init amend order keep priority service
+func (c *Client) NewAmendOrderKeepPriorityService() *AmendOrderKeepPriorityService {
+	return &AmendOrderKeepPriorityService{c: c, checkRemaining: true}
+}
+
 // NewCancelOpenOrdersService init cancel open orders service
 func (c *Client) NewCancelOpenOrdersService() *CancelOpenOrdersService {
 	return &CancelOpenOrdersService{c: c}
@@ -730,6 +886,11 @@ func (c *Client) NewGetMarginAllPairsService() *GetMarginAllPairsService {
 	return &GetMarginAllPairsService{c: c}
 }
 
+// NewGetAllMarginPairsService init get all margin pairs service, see NewGetMarginAllPairsService
+func (c *Client) NewGetAllMarginPairsService() *GetAllMarginPairsService {
+	return &GetAllMarginPairsService{c: c}
+}
+
 // NewGetMarginPriceIndexService init get margin price index service
 func (c *Client) NewGetMarginPriceIndexService() *GetMarginPriceIndexService {
 	return &GetMarginPriceIndexService{c: c}
@@ -750,6 +911,21 @@ func (c *Client) NewListMarginTradesService() *ListMarginTradesService {
 	return &ListMarginTradesService{c: c}
 }
 
+// NewGetMarginForceLiquidationRecordService init get margin force liquidation record service
+func (c *Client) NewGetMarginForceLiquidationRecordService() *GetMarginForceLiquidationRecordService {
+	return &GetMarginForceLiquidationRecordService{c: c}
+}
+
+// NewGetCrossMarginCollateralRatioService init get cross margin collateral ratio service
+func (c *Client) NewGetCrossMarginCollateralRatioService() *GetCrossMarginCollateralRatioService {
+	return &GetCrossMarginCollateralRatioService{c: c}
+}
+
+// NewGetSmallLiabilityExchangeService init get small liability exchange service
+func (c *Client) NewGetSmallLiabilityExchangeService() *GetSmallLiabilityExchangeService {
+	return &GetSmallLiabilityExchangeService{c: c}
+}
+
 // NewGetMaxBorrowableService init get max borrowable service
 func (c *Client) NewGetMaxBorrowableService() *GetMaxBorrowableService {
 	return &GetMaxBorrowableService{c: c}
@@ -910,6 +1086,21 @@ func (c *Client) NewStakingHistoryService() *StakingHistoryService {
 	return &StakingHistoryService{c: c}
 }
 
+// NewGetStakingProductListService init the staking product list service
+func (c *Client) NewGetStakingProductListService() *GetStakingProductListService {
+	return &GetStakingProductListService{c: c}
+}
+
+// NewPurchaseStakingProductService init the staking product purchase service
+func (c *Client) NewPurchaseStakingProductService() *PurchaseStakingProductService {
+	return &PurchaseStakingProductService{c: c}
+}
+
+// NewRedeemStakingProductService init the staking product redemption service
+func (c *Client) NewRedeemStakingProductService() *RedeemStakingProductService {
+	return &RedeemStakingProductService{c: c}
+}
+
 // NewGetAllLiquidityPoolService init the get all swap pool service
 func (c *Client) NewGetAllLiquidityPoolService() *GetAllLiquidityPoolService {
 	return &GetAllLiquidityPoolService{c: c}
@@ -1009,3 +1200,48 @@ func (c *Client) NewManagedSubAccountAssetsService() *ManagedSubAccountAssetsSer
 func (c *Client) NewSubAccountFuturesAccountService() *SubAccountFuturesAccountService {
 	return &SubAccountFuturesAccountService{c: c}
 }
+
+// NewGetBlvtInfoService init get leveraged token info service
+func (c *Client) NewGetBlvtInfoService() *GetBlvtInfoService {
+	return &GetBlvtInfoService{c: c}
+}
+
+// NewSubscribeBlvtService init subscribe leveraged token service
+func (c *Client) NewSubscribeBlvtService() *SubscribeBlvtService {
+	return &SubscribeBlvtService{c: c}
+}
+
+// NewRedeemBlvtService init redeem leveraged token service
+func (c *Client) NewRedeemBlvtService() *RedeemBlvtService {
+	return &RedeemBlvtService{c: c}
+}
+
+// NewGetBlvtSubscriptionRecordService init leveraged token subscription record service
+func (c *Client) NewGetBlvtSubscriptionRecordService() *GetBlvtSubscriptionRecordService {
+	return &GetBlvtSubscriptionRecordService{c: c}
+}
+
+// NewGetBlvtRedemptionRecordService init leveraged token redemption record service
+func (c *Client) NewGetBlvtRedemptionRecordService() *GetBlvtRedemptionRecordService {
+	return &GetBlvtRedemptionRecordService{c: c}
+}
+
+// NewGetNFTTransactionHistoryService init NFT transaction history service
+func (c *Client) NewGetNFTTransactionHistoryService() *GetNFTTransactionHistoryService {
+	return &GetNFTTransactionHistoryService{c: c}
+}
+
+// NewGetNFTDepositHistoryService init NFT deposit history service
+func (c *Client) NewGetNFTDepositHistoryService() *GetNFTDepositHistoryService {
+	return &GetNFTDepositHistoryService{c: c}
+}
+
+// NewGetNFTWithdrawHistoryService init NFT withdraw history service
+func (c *Client) NewGetNFTWithdrawHistoryService() *GetNFTWithdrawHistoryService {
+	return &GetNFTWithdrawHistoryService{c: c}
+}
+
+// NewGetNFTUserAssetService init NFT user asset service
+func (c *Client) NewGetNFTUserAssetService() *GetNFTUserAssetService {
+	return &GetNFTUserAssetService{c: c}
+}
@@ -3,9 +3,11 @@ package binance
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +15,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 )
 
 type baseTestSuite struct {
@@ -167,3 +171,103 @@ func TestFormatTimestamp(t *testing.T) {
 	tm, _ := time.Parse("2006-01-02 15:04:05", "2018-06-01 01:01:01")
 	assert.Equal(t, int64(1527814861000), FormatTimestamp(tm))
 }
+
+type recordLogger struct {
+	lines []string
+}
+
+func (l *recordLogger) record(level, msg string, keyvals []interface{}) {
+	l.lines = append(l.lines, fmt.Sprint(append([]interface{}{level, msg}, keyvals...)...))
+}
+
+func (l *recordLogger) Debug(msg string, keyvals ...interface{}) { l.record("DEBUG", msg, keyvals) }
+func (l *recordLogger) Info(msg string, keyvals ...interface{})  { l.record("INFO", msg, keyvals) }
+func (l *recordLogger) Error(msg string, keyvals ...interface{}) { l.record("ERROR", msg, keyvals) }
+
+type clientTestSuite struct {
+	baseTestSuite
+}
+
+func TestClient(t *testing.T) {
+	suite.Run(t, new(clientTestSuite))
+}
+
+func (s *clientTestSuite) TestStructuredLoggerRedact() {
+	listenKey := "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"
+	s.mockDo([]byte(fmt.Sprintf(`{"listenKey": "%s"}`, listenKey)), nil)
+	defer s.assertDo()
+
+	logger := new(recordLogger)
+	s.client.StructuredLogger = logger
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+
+	s.r().NotEmpty(logger.lines)
+	all := strings.Join(logger.lines, "\n")
+	s.r().Contains(all, "signature=***")
+	s.r().NotContains(all, s.apiKey)
+	s.r().NotContains(all, listenKey)
+}
+
+func (s *clientTestSuite) TestDebugEnabled() {
+	r := s.r()
+	s.client.Debug = false
+	r.False(s.client.debugEnabled())
+	s.client.Debug = true
+	r.True(s.client.debugEnabled())
+	s.client.Debug = false
+	s.client.StructuredLogger = new(recordLogger)
+	r.True(s.client.debugEnabled())
+}
+
+type recordHook struct {
+	start []*common.RequestInfo
+	end   []*common.ResponseInfo
+}
+
+type hookCtxKey struct{}
+
+func (h *recordHook) OnRequestStart(ctx context.Context, req *common.RequestInfo) context.Context {
+	h.start = append(h.start, req)
+	return context.WithValue(ctx, hookCtxKey{}, req.Endpoint)
+}
+
+func (h *recordHook) OnRequestEnd(ctx context.Context, req *common.RequestInfo, res *common.ResponseInfo) {
+	if ctx.Value(hookCtxKey{}) == req.Endpoint {
+		h.end = append(h.end, res)
+	}
+}
+
+func (s *clientTestSuite) TestRequestHook() {
+	s.mockDo([]byte(`{"code": -1121, "msg": "Invalid symbol."}`), nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	hook := new(recordHook)
+	s.client.RequestHook = hook
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.Len(hook.start, 1)
+	r.Equal(http.MethodGet, hook.start[0].Method)
+	r.Equal("/api/v3/account", hook.start[0].Endpoint)
+	r.Len(hook.end, 1)
+	r.Equal(http.StatusBadRequest, hook.end[0].StatusCode)
+	r.Equal(err, hook.end[0].Err)
+}
+
+func (s *clientTestSuite) TestWithDebug() {
+	s.mockDo([]byte(`{"makerCommission": 15, "takerCommission": 15}`), nil)
+	defer s.assertDo()
+
+	var b bytes.Buffer
+	s.client.applyOptions([]ClientOption{WithDebug(&b), WithDebugBodyLimit(16)})
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	r.Len(lines, 2)
+	r.Contains(lines[0], " request GET "+s.client.BaseURL+"/api/v3/account?")
+	r.Contains(lines[0], "signature=***")
+	r.Contains(lines[1], ` response 200 `)
+	r.Contains(lines[1], `body={"makerCommissio...(30 bytes truncated)`)
+}
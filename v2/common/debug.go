@@ -0,0 +1,46 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// DefaultDebugBodyLimit is the default number of body bytes written by a DebugWriter
+const DefaultDebugBodyLimit = 4096
+
+// DebugWriter write the raw requests and responses of a client, with secrets redacted
+type DebugWriter struct {
+	w     io.Writer
+	limit int
+	mu    sync.Mutex
+}
+
+// NewDebugWriter init a debug writer, bodies longer than limit bytes are truncated,
+// a limit <= 0 means DefaultDebugBodyLimit
+func NewDebugWriter(w io.Writer, limit int) *DebugWriter {
+	if limit <= 0 {
+		limit = DefaultDebugBodyLimit
+	}
+	return &DebugWriter{w: w, limit: limit}
+}
+
+func (d *DebugWriter) write(kind, head, body string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(body) > d.limit {
+		body = fmt.Sprintf("%s...(%d bytes truncated)", body[:d.limit], len(body)-d.limit)
+	}
+	fmt.Fprintf(d.w, "%s %s %s body=%s\n", time.Now().UTC().Format(time.RFC3339Nano), kind, head, body)
+}
+
+// Request write a request url and body
+func (d *DebugWriter) Request(method, url, body string) {
+	d.write("request", method+" "+Redact(url), Redact(body))
+}
+
+// Response write a response status and body
+func (d *DebugWriter) Response(statusCode int, url string, body []byte) {
+	d.write("response", fmt.Sprintf("%d %s", statusCode, Redact(url)), Redact(string(body)))
+}
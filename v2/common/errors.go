@@ -1,9 +1,13 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrInvalidParam is wrapped by the errors returned before sending a request with an invalid parameter
+var ErrInvalidParam = errors.New("invalid parameter")
+
 // APIError define API error when response status is 4xx or 5xx
 type APIError struct {
 	Code    int64  `json:"code"`
@@ -20,3 +24,9 @@ func IsAPIError(e error) bool {
 	_, ok := e.(*APIError)
 	return ok
 }
+
+// IsAPIErrorCode check if e is, or wraps, an API error of the given code
+func IsAPIErrorCode(e error, code int64) bool {
+	var apiErr *APIError
+	return errors.As(e, &apiErr) && apiErr.Code == code
+}
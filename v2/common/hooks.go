@@ -0,0 +1,58 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RequestInfo define a REST request seen by a RequestHook
+type RequestInfo struct {
+	Method   string
+	Endpoint string
+	Start    time.Time
+}
+
+// ResponseInfo define the outcome of a REST request seen by a RequestHook
+type ResponseInfo struct {
+	// StatusCode is zero when the request failed before a response was received
+	StatusCode int
+	Duration   time.Duration
+	// UsedWeight is the request weight used in the current window as reported by the
+	// X-MBX-USED-WEIGHT-* response header, zero if absent
+	UsedWeight int64
+	Err        error
+}
+
+// RequestHook is called around every REST request of a client, the context returned by
+// OnRequestStart is the one used for the request and passed to OnRequestEnd
+type RequestHook interface {
+	OnRequestStart(ctx context.Context, req *RequestInfo) context.Context
+	OnRequestEnd(ctx context.Context, req *RequestInfo, res *ResponseInfo)
+}
+
+// WsHook receives websocket stream metrics.
+// Reconnects show up as repeated OnConnect calls for the same endpoint.
+type WsHook interface {
+	OnConnect(endpoint string)
+	OnMessage(endpoint string, size int, handlerLatency time.Duration)
+	OnDisconnect(endpoint string, err error)
+}
+
+// UsedWeight return the used request weight from the X-MBX-USED-WEIGHT-* headers,
+// preferring the one minute window
+func UsedWeight(header http.Header) int64 {
+	if v := header.Get("X-Mbx-Used-Weight-1m"); v != "" {
+		w, _ := strconv.ParseInt(v, 10, 64)
+		return w
+	}
+	for k, v := range header {
+		if strings.HasPrefix(http.CanonicalHeaderKey(k), "X-Mbx-Used-Weight") && len(v) > 0 {
+			w, _ := strconv.ParseInt(v[0], 10, 64)
+			return w
+		}
+	}
+	return 0
+}
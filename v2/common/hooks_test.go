@@ -0,0 +1,18 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsedWeight(t *testing.T) {
+	assert := assert.New(t)
+	h := http.Header{}
+	assert.Equal(int64(0), UsedWeight(h))
+	h.Set("X-MBX-USED-WEIGHT", "12")
+	assert.Equal(int64(12), UsedWeight(h))
+	h.Set("X-MBX-USED-WEIGHT-1M", "34")
+	assert.Equal(int64(34), UsedWeight(h))
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Logger define a structured logger, keyvals are alternating keys and values
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, keyvals ...interface{}) {}
+func (nopLogger) Info(msg string, keyvals ...interface{})  {}
+func (nopLogger) Error(msg string, keyvals ...interface{}) {}
+
+// NopLogger is a Logger discarding everything
+var NopLogger Logger = nopLogger{}
+
+type stdLogger struct {
+	l *log.Logger
+}
+
+// NewStdLogger wrap a standard library logger as a Logger, printing lines as "LEVEL msg key=value ..."
+func NewStdLogger(l *log.Logger) Logger {
+	return &stdLogger{l: l}
+}
+
+func (s *stdLogger) print(level, msg string, keyvals []interface{}) {
+	var b strings.Builder
+	b.WriteString(level)
+	b.WriteByte(' ')
+	b.WriteString(msg)
+	for i := 0; i < len(keyvals); i += 2 {
+		b.WriteByte(' ')
+		fmt.Fprint(&b, keyvals[i])
+		b.WriteByte('=')
+		if i+1 < len(keyvals) {
+			fmt.Fprint(&b, keyvals[i+1])
+		}
+	}
+	s.l.Print(b.String())
+}
+
+func (s *stdLogger) Debug(msg string, keyvals ...interface{}) { s.print("DEBUG", msg, keyvals) }
+func (s *stdLogger) Info(msg string, keyvals ...interface{})  { s.print("INFO", msg, keyvals) }
+func (s *stdLogger) Error(msg string, keyvals ...interface{}) { s.print("ERROR", msg, keyvals) }
+
+// Redacted replaces secret values in logs
+const Redacted = "***"
+
+var (
+	redactParamRe = regexp.MustCompile(`((?:^|[?&])(?:signature|listenKey)=)[^&\s]*`)
+	redactJSONRe  = regexp.MustCompile(`("listenKey"\s*:\s*")[^"]*`)
+	// listen keys are the only path segment of a raw stream url without a stream name separator
+	redactWsRe = regexp.MustCompile(`(/ws/)[A-Za-z0-9]{32,}$`)
+)
+
+// RedactHeaderKeys are the request headers whose values are replaced by Redacted
+var RedactHeaderKeys = []string{"X-MBX-APIKEY"}
+
+// Redact replace the signature and listenKey values of a query string, form body, url or JSON payload
+func Redact(s string) string {
+	s = redactParamRe.ReplaceAllString(s, "${1}"+Redacted)
+	return redactJSONRe.ReplaceAllString(s, "${1}"+Redacted)
+}
+
+// RedactWsEndpoint replace the listen key of a user data stream endpoint
+func RedactWsEndpoint(endpoint string) string {
+	return redactWsRe.ReplaceAllString(endpoint, "${1}"+Redacted)
+}
+
+// RedactHeader return a copy of header with the values of RedactHeaderKeys replaced
+func RedactHeader(header http.Header) http.Header {
+	h := header.Clone()
+	for _, k := range RedactHeaderKeys {
+		if h.Get(k) != "" {
+			h.Set(k, Redacted)
+		}
+	}
+	return h
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "signed url",
+			in:   "https://api.binance.com/api/v3/order?symbol=BTCUSDT&timestamp=1&signature=abcdef",
+			want: "https://api.binance.com/api/v3/order?symbol=BTCUSDT&timestamp=1&signature=***",
+		},
+		{
+			name: "form body",
+			in:   "listenKey=pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1&recvWindow=5000",
+			want: "listenKey=***&recvWindow=5000",
+		},
+		{
+			name: "json body",
+			in:   `{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"}`,
+			want: `{"listenKey": "***"}`,
+		},
+		{
+			name: "nothing to redact",
+			in:   "symbol=BTCUSDT&limit=5",
+			want: "symbol=BTCUSDT&limit=5",
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.want, Redact(tt.in), tt.name)
+	}
+}
+
+func TestRedactWsEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("wss://fstream.binance.com/ws/***",
+		RedactWsEndpoint("wss://fstream.binance.com/ws/pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"))
+	assert.Equal("wss://fstream.binance.com/ws/btcusdt@aggTrade",
+		RedactWsEndpoint("wss://fstream.binance.com/ws/btcusdt@aggTrade"))
+}
+
+func TestRedactHeader(t *testing.T) {
+	assert := assert.New(t)
+	h := http.Header{}
+	h.Set("X-MBX-APIKEY", "dummyAPIKey")
+	h.Set("Content-Type", "application/x-www-form-urlencoded")
+	r := RedactHeader(h)
+	assert.Equal(Redacted, r.Get("X-MBX-APIKEY"))
+	assert.Equal("application/x-www-form-urlencoded", r.Get("Content-Type"))
+	assert.Equal("dummyAPIKey", h.Get("X-MBX-APIKEY"))
+}
+
+func TestStdLogger(t *testing.T) {
+	var b bytes.Buffer
+	l := NewStdLogger(log.New(&b, "", 0))
+	l.Info("ws connected", "endpoint", "wss://fstream.binance.com/ws/btcusdt@depth", "count", 1)
+	assert.Equal(t, "INFO ws connected endpoint=wss://fstream.binance.com/ws/btcusdt@depth count=1\n", b.String())
+}
+
+func TestDebugWriter(t *testing.T) {
+	assert := assert.New(t)
+	var b bytes.Buffer
+	d := NewDebugWriter(&b, 10)
+	d.Request("GET", "https://api.binance.com/api/v3/account?timestamp=1&signature=abcdef", "")
+	d.Response(200, "https://api.binance.com/api/v3/account?timestamp=1&signature=abcdef", []byte(`{"balances":[1,2,3,4,5,6]}`))
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	assert.Len(lines, 2)
+	assert.True(strings.HasSuffix(lines[0], " request GET https://api.binance.com/api/v3/account?timestamp=1&signature=*** body="), lines[0])
+	assert.True(strings.HasSuffix(lines[1], ` response 200 https://api.binance.com/api/v3/account?timestamp=1&signature=*** body={"balances...(16 bytes truncated)`), lines[1])
+	assert.NotContains(b.String(), "abcdef")
+}
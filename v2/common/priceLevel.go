@@ -1,6 +1,11 @@
 package common
 
-import "strconv"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
 
 // PriceLevel is a common structure for bids and asks in the
 // order book.
@@ -23,3 +28,26 @@ func (p *PriceLevel) Parse() (float64, float64, error) {
 	}
 	return price, quantity, nil
 }
+
+// UnmarshalJSON decode the [price, quantity] pair sent by the depth endpoints and streams,
+// the {"Price": price, "Quantity": quantity} form of the standard encoding is accepted too
+func (p *PriceLevel) UnmarshalJSON(data []byte) error {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		type priceLevel PriceLevel
+		return json.Unmarshal(data, (*priceLevel)(p))
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) < 2 {
+		return fmt.Errorf("invalid price level %s", data)
+	}
+	if err := json.Unmarshal(items[0], &p.Price); err != nil {
+		return fmt.Errorf("invalid price level price: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &p.Quantity); err != nil {
+		return fmt.Errorf("invalid price level quantity: %w", err)
+	}
+	return nil
+}
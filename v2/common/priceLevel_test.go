@@ -0,0 +1,27 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceLevelUnmarshalJSON(t *testing.T) {
+	var levels []PriceLevel
+	err := json.Unmarshal([]byte(`[["4.00000000", "431.00000000"], ["4.00000200", "12.00000000", []]]`), &levels)
+	assert.NoError(t, err)
+	assert.Equal(t, []PriceLevel{
+		{Price: "4.00000000", Quantity: "431.00000000"},
+		{Price: "4.00000200", Quantity: "12.00000000"},
+	}, levels)
+
+	data, err := json.Marshal(levels[0])
+	assert.NoError(t, err)
+	var level PriceLevel
+	assert.NoError(t, json.Unmarshal(data, &level))
+	assert.Equal(t, levels[0], level)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`["4.00000000"]`), &level), `invalid price level ["4.00000000"]`)
+	assert.Error(t, json.Unmarshal([]byte(`[4.0, "431.00000000"]`), &level))
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy define what a WsBuffer does with a message pushed when it is full
+type OverflowPolicy int
+
+const (
+	// OverflowBlock wait for the handler to make room, slowing down the read loop
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest drop the oldest buffered message to make room
+	OverflowDropOldest
+	// OverflowDropNewest drop the pushed message
+	OverflowDropNewest
+)
+
+// WsOverflowError is reported to the error handler of a stream every time a buffered message is dropped
+type WsOverflowError struct {
+	Endpoint string
+	// Dropped is the number of messages dropped since the stream started
+	Dropped uint64
+}
+
+func (e *WsOverflowError) Error() string {
+	return fmt.Sprintf("ws buffer overflow on %s: %d messages dropped", e.Endpoint, e.Dropped)
+}
+
+// WsBuffer decouple the read loop of a websocket from its handler with a bounded queue,
+// the handler is called in order from a single goroutine
+type WsBuffer struct {
+	queue   chan []byte
+	policy  OverflowPolicy
+	onDrop  func(dropped uint64)
+	dropped uint64
+	wg      sync.WaitGroup
+}
+
+// NewWsBuffer start a WsBuffer of size messages dispatching to handler,
+// onDrop is called with the total of dropped messages after every drop
+func NewWsBuffer(size int, policy OverflowPolicy, handler func(message []byte), onDrop func(dropped uint64)) *WsBuffer {
+	b := &WsBuffer{
+		queue:  make(chan []byte, size),
+		policy: policy,
+		onDrop: onDrop,
+	}
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		for message := range b.queue {
+			handler(message)
+		}
+	}()
+	return b
+}
+
+// Push queue a message according to the overflow policy, it must not be called after Close
+func (b *WsBuffer) Push(message []byte) {
+	switch b.policy {
+	case OverflowDropNewest:
+		select {
+		case b.queue <- message:
+		default:
+			b.drop()
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case b.queue <- message:
+				return
+			default:
+			}
+			select {
+			case <-b.queue:
+				b.drop()
+			default:
+			}
+		}
+	default:
+		b.queue <- message
+	}
+}
+
+// Dropped return the number of messages dropped so far
+func (b *WsBuffer) Dropped() uint64 {
+	return atomic.LoadUint64(&b.dropped)
+}
+
+// Close wait for the buffered messages to be handled
+func (b *WsBuffer) Close() {
+	close(b.queue)
+	b.wg.Wait()
+}
+
+func (b *WsBuffer) drop() {
+	dropped := atomic.AddUint64(&b.dropped, 1)
+	if b.onDrop != nil {
+		b.onDrop(dropped)
+	}
+}
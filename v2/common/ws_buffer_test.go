@@ -0,0 +1,69 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWsBuffer(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  OverflowPolicy
+		handled []string
+		dropped uint64
+	}{
+		{"block", OverflowBlock, []string{"1", "2", "3", "4"}, 0},
+		{"drop oldest", OverflowDropOldest, []string{"1", "3", "4"}, 1},
+		{"drop newest", OverflowDropNewest, []string{"1", "2", "3"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var handled []string
+			var drops []uint64
+			b := NewWsBuffer(2, tt.policy, func(message []byte) {
+				if string(message) == "1" {
+					close(started)
+					<-release
+				}
+				handled = append(handled, string(message))
+			}, func(dropped uint64) {
+				drops = append(drops, dropped)
+			})
+			b.Push([]byte("1"))
+			<-started
+			b.Push([]byte("2"))
+			b.Push([]byte("3"))
+			// the queue is full while the handler is busy with 1
+			pushed := make(chan struct{})
+			go func() {
+				b.Push([]byte("4"))
+				close(pushed)
+			}()
+			if tt.policy == OverflowBlock {
+				select {
+				case <-pushed:
+					r.Fail("push did not block")
+				case <-time.After(10 * time.Millisecond):
+				}
+			} else {
+				<-pushed
+			}
+			close(release)
+			<-pushed
+			b.Close()
+			r.Equal(tt.handled, handled)
+			r.Equal(tt.dropped, b.Dropped())
+			r.Len(drops, int(tt.dropped))
+		})
+	}
+}
+
+func TestWsOverflowError(t *testing.T) {
+	err := &WsOverflowError{Endpoint: "wss://example/ws/btcusdt@aggTrade", Dropped: 3}
+	require.Equal(t, "ws buffer overflow on wss://example/ws/btcusdt@aggTrade: 3 messages dropped", err.Error())
+}
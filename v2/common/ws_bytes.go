@@ -0,0 +1,18 @@
+package common
+
+import "sync/atomic"
+
+// WsByteCounter count the bytes received by a websocket connection, it is safe for concurrent use
+type WsByteCounter struct {
+	bytes uint64
+}
+
+// Add count n bytes received
+func (c *WsByteCounter) Add(n int) {
+	atomic.AddUint64(&c.bytes, uint64(n))
+}
+
+// Load return the number of bytes received so far
+func (c *WsByteCounter) Load() uint64 {
+	return atomic.LoadUint64(&c.bytes)
+}
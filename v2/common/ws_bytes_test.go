@@ -0,0 +1,27 @@
+package common
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWsByteCounter(t *testing.T) {
+	var counter WsByteCounter
+	assert.Equal(t, uint64(0), counter.Load())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				counter.Add(3)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(3000), counter.Load())
+}
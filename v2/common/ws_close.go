@@ -0,0 +1,89 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gorilla/websocket"
+)
+
+// WsCloseError is reported to the error handler of a stream when its connection is closed by the server,
+// Code is the RFC 6455 close code, e.g. 1000 when Binance rotates a connection after 24 hours,
+// 1008 when a connection violates a policy such as too many streams, or 1006 when the connection
+// is lost without a close frame.
+type WsCloseError struct {
+	Endpoint string
+	Code     int
+	Text     string
+	// Err is the *websocket.CloseError returned by the websocket library
+	Err error
+}
+
+func (e *WsCloseError) Error() string {
+	return fmt.Sprintf("ws closed on %s: code=%d, text=%s", e.Endpoint, e.Code, e.Text)
+}
+
+// Unwrap return the *websocket.CloseError of the close
+func (e *WsCloseError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable report whether the stream can be served again on a new connection. The normal closure (1000),
+// going away (1001), abnormal closure (1006), internal server error (1011), service restart (1012) and
+// try again later (1013) codes are retryable, the other codes, such as the policy violation (1008) or
+// the message too big (1009) codes, fail again with the same subscription. There is no auto-reconnect
+// yet, so this is the policy to follow when serving a stream again from the error handler.
+func (e *WsCloseError) Retryable() bool {
+	switch e.Code {
+	case websocket.CloseNormalClosure,
+		websocket.CloseGoingAway,
+		websocket.CloseAbnormalClosure,
+		websocket.CloseInternalServerErr,
+		websocket.CloseServiceRestart,
+		websocket.CloseTryAgainLater:
+		return true
+	}
+	return false
+}
+
+// NewWsCloseError wrap err in a *WsCloseError when it is a websocket close error, other errors are returned as is
+func NewWsCloseError(endpoint string, err error) error {
+	var closeErr *websocket.CloseError
+	if !errors.As(err, &closeErr) {
+		return err
+	}
+	return &WsCloseError{
+		Endpoint: endpoint,
+		Code:     closeErr.Code,
+		Text:     closeErr.Text,
+		Err:      err,
+	}
+}
+
+// WsMessageTooBigError is reported to the error handler of a stream when a message exceeds the read
+// limit of its connection, the connection is then closed with the message too big (1009) code
+type WsMessageTooBigError struct {
+	Endpoint string
+	// Limit is the read limit in bytes, the size of the message is not known as it is not read
+	Limit int64
+	// Err is the websocket.ErrReadLimit returned by the websocket library
+	Err error
+}
+
+func (e *WsMessageTooBigError) Error() string {
+	return fmt.Sprintf("ws message on %s exceeds the read limit of %d bytes", e.Endpoint, e.Limit)
+}
+
+// Unwrap return the websocket.ErrReadLimit of the message
+func (e *WsMessageTooBigError) Unwrap() error {
+	return e.Err
+}
+
+// NewWsReadError wrap an error of the read loop of a stream in a *WsMessageTooBigError when a message
+// exceeds limit, or a *WsCloseError when the connection is closed, other errors are returned as is
+func NewWsReadError(endpoint string, limit int64, err error) error {
+	if errors.Is(err, websocket.ErrReadLimit) {
+		return &WsMessageTooBigError{Endpoint: endpoint, Limit: limit, Err: err}
+	}
+	return NewWsCloseError(endpoint, err)
+}
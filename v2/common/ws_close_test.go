@@ -0,0 +1,57 @@
+package common
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWsCloseError(t *testing.T) {
+	closeErr := &websocket.CloseError{Code: websocket.ClosePolicyViolation, Text: "too many streams"}
+	err := NewWsCloseError("wss://stream", closeErr)
+
+	var wsCloseErr *WsCloseError
+	assert.True(t, errors.As(err, &wsCloseErr))
+	assert.Equal(t, websocket.ClosePolicyViolation, wsCloseErr.Code)
+	assert.Equal(t, "too many streams", wsCloseErr.Text)
+	assert.Equal(t, "ws closed on wss://stream: code=1008, text=too many streams", err.Error())
+	assert.ErrorIs(t, err, closeErr)
+
+	assert.Equal(t, io.EOF, NewWsCloseError("wss://stream", io.EOF))
+	assert.Nil(t, NewWsCloseError("wss://stream", nil))
+}
+
+func TestWsCloseErrorRetryable(t *testing.T) {
+	for code, retryable := range map[int]bool{
+		websocket.CloseNormalClosure:           true,
+		websocket.CloseGoingAway:               true,
+		websocket.CloseAbnormalClosure:         true,
+		websocket.CloseInternalServerErr:       true,
+		websocket.CloseServiceRestart:          true,
+		websocket.CloseTryAgainLater:           true,
+		websocket.ClosePolicyViolation:         false,
+		websocket.CloseMessageTooBig:           false,
+		websocket.CloseUnsupportedData:         false,
+		websocket.CloseInvalidFramePayloadData: false,
+	} {
+		err := &WsCloseError{Code: code}
+		assert.Equal(t, retryable, err.Retryable(), "code %d", code)
+	}
+}
+
+func TestNewWsReadError(t *testing.T) {
+	err := NewWsReadError("wss://stream", 1024, websocket.ErrReadLimit)
+	var tooBigErr *WsMessageTooBigError
+	assert.True(t, errors.As(err, &tooBigErr))
+	assert.Equal(t, int64(1024), tooBigErr.Limit)
+	assert.Equal(t, "ws message on wss://stream exceeds the read limit of 1024 bytes", err.Error())
+	assert.ErrorIs(t, err, websocket.ErrReadLimit)
+
+	closeErr := &websocket.CloseError{Code: websocket.CloseGoingAway}
+	var wsCloseErr *WsCloseError
+	assert.True(t, errors.As(NewWsReadError("wss://stream", 1024, closeErr), &wsCloseErr))
+	assert.Equal(t, io.EOF, NewWsReadError("wss://stream", 1024, io.EOF))
+}
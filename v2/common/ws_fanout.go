@@ -0,0 +1,76 @@
+package common
+
+import (
+	"strconv"
+	"sync"
+)
+
+// WsFanOut share the events of one stream between several handlers, pass its Handle method
+// as the handler of a Serve function, e.g. binance.WsAggTradeServe(symbol, f.Handle, errHandler).
+// Handlers are called in registration order from the goroutine of the stream, so a slow handler
+// delays the others as much as the dispatch policy of the stream allows.
+type WsFanOut[E any] struct {
+	// ErrHandler receives the recovered panics of handlers as a *WsHandlerPanicError
+	// whose key is the handler id, the other handlers still get the event
+	ErrHandler func(err error)
+
+	mu       sync.Mutex
+	nextID   int
+	handlers []fanOutHandler[E]
+}
+
+type fanOutHandler[E any] struct {
+	id      int
+	handler func(event E)
+}
+
+// Add register a handler and return its id, it can be called while the stream is live
+func (f *WsFanOut[E]) Add(handler func(event E)) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	handlers := make([]fanOutHandler[E], len(f.handlers), len(f.handlers)+1)
+	copy(handlers, f.handlers)
+	f.handlers = append(handlers, fanOutHandler[E]{id: f.nextID, handler: handler})
+	return f.nextID
+}
+
+// Remove unregister the handler of id, it can be called while the stream is live,
+// including from a handler
+func (f *WsFanOut[E]) Remove(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	handlers := make([]fanOutHandler[E], 0, len(f.handlers))
+	for _, h := range f.handlers {
+		if h.id != id {
+			handlers = append(handlers, h)
+		}
+	}
+	f.handlers = handlers
+}
+
+// Len return the number of registered handlers
+func (f *WsFanOut[E]) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.handlers)
+}
+
+// Handle call every registered handler with event
+func (f *WsFanOut[E]) Handle(event E) {
+	f.mu.Lock()
+	handlers := f.handlers
+	f.mu.Unlock()
+	for _, h := range handlers {
+		f.call(h, event)
+	}
+}
+
+func (f *WsFanOut[E]) call(h fanOutHandler[E], event E) {
+	defer func() {
+		if v := recover(); v != nil && f.ErrHandler != nil {
+			f.ErrHandler(&WsHandlerPanicError{Key: strconv.Itoa(h.id), Value: v})
+		}
+	}()
+	h.handler(event)
+}
@@ -0,0 +1,92 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fanOutEvent struct {
+	ID int
+}
+
+type fanOutEventHandler func(event *fanOutEvent)
+
+func TestWsFanOut(t *testing.T) {
+	r := require.New(t)
+	var errs []error
+	f := &WsFanOut[*fanOutEvent]{ErrHandler: func(err error) {
+		errs = append(errs, err)
+	}}
+	var calls []string
+	strategy := f.Add(func(event *fanOutEvent) {
+		calls = append(calls, "strategy")
+	})
+	f.Add(func(event *fanOutEvent) {
+		if event.ID == 2 {
+			panic("boom")
+		}
+		calls = append(calls, "recorder")
+	})
+	// the Handle method value is usable as a typed handler
+	var handler fanOutEventHandler = f.Handle
+
+	handler(&fanOutEvent{ID: 1})
+	r.Equal([]string{"strategy", "recorder"}, calls)
+
+	calls = nil
+	handler(&fanOutEvent{ID: 2})
+	r.Equal([]string{"strategy"}, calls)
+	r.Len(errs, 1)
+	var panicErr *WsHandlerPanicError
+	r.True(errors.As(errs[0], &panicErr))
+	r.Equal("2", panicErr.Key)
+
+	calls = nil
+	f.Remove(strategy)
+	r.Equal(1, f.Len())
+	handler(&fanOutEvent{ID: 3})
+	r.Equal([]string{"recorder"}, calls)
+}
+
+func TestWsFanOutRemoveWhileHandling(t *testing.T) {
+	r := require.New(t)
+	f := new(WsFanOut[int])
+	var mu sync.Mutex
+	counts := map[string]int{}
+	count := func(name string) {
+		mu.Lock()
+		counts[name]++
+		mu.Unlock()
+	}
+	var once int
+	once = f.Add(func(event int) {
+		count("once")
+		f.Remove(once)
+	})
+	f.Add(func(event int) {
+		count("always")
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			f.Handle(i)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		id := f.Add(func(event int) {})
+		f.Remove(id)
+	}
+	wg.Wait()
+
+	f.Handle(-1)
+	mu.Lock()
+	defer mu.Unlock()
+	r.Equal(1, counts["once"])
+	r.Equal(1001, counts["always"])
+}
@@ -0,0 +1,119 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// WsWorkerPoolConfig define a pool of workers handling the messages of a stream concurrently,
+// the messages of a same key are handled in order by the same worker
+type WsWorkerPoolConfig struct {
+	Workers int
+	// QueueSize is the number of messages queued per worker, the read loop blocks when
+	// the queue of a worker is full, default to 64
+	QueueSize int
+	// Key return the ordering key of a message, default to CombinedStreamSymbol
+	Key func(message []byte) string
+	// Drain handle the queued messages when the stream stops, instead of abandoning them
+	Drain bool
+}
+
+// CombinedStreamSymbol return the symbol of a combined stream message, the part of its
+// stream name before the @, or an empty string for the messages of other streams
+func CombinedStreamSymbol(message []byte) string {
+	var envelope struct {
+		Stream string `json:"stream"`
+	}
+	if err := json.Unmarshal(message, &envelope); err != nil {
+		return ""
+	}
+	return strings.Split(envelope.Stream, "@")[0]
+}
+
+// WsHandlerPanicError is reported to the error handler of a stream when its handler panics in a worker,
+// the other workers keep running
+type WsHandlerPanicError struct {
+	Key   string
+	Value interface{}
+}
+
+func (e *WsHandlerPanicError) Error() string {
+	return fmt.Sprintf("ws handler panic on key %q: %v", e.Key, e.Value)
+}
+
+// WsWorkerPool dispatch the messages of a stream to the workers of a WsWorkerPoolConfig
+type WsWorkerPool struct {
+	queues    []chan []byte
+	key       func(message []byte) string
+	drain     bool
+	abandoned int32
+	wg        sync.WaitGroup
+}
+
+// NewWsWorkerPool start the workers of cfg calling handler, onPanic is called with the recovered
+// panics of handler
+func NewWsWorkerPool(cfg WsWorkerPoolConfig, handler func(message []byte), onPanic func(err *WsHandlerPanicError)) *WsWorkerPool {
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	size := cfg.QueueSize
+	if size <= 0 {
+		size = 64
+	}
+	p := &WsWorkerPool{
+		queues: make([]chan []byte, workers),
+		key:    cfg.Key,
+		drain:  cfg.Drain,
+	}
+	if p.key == nil {
+		p.key = CombinedStreamSymbol
+	}
+	for i := range p.queues {
+		p.queues[i] = make(chan []byte, size)
+		p.wg.Add(1)
+		go p.work(p.queues[i], handler, onPanic)
+	}
+	return p
+}
+
+// Push queue a message to the worker of its key, it must not be called after Close
+func (p *WsWorkerPool) Push(message []byte) {
+	h := fnv.New32a()
+	h.Write([]byte(p.key(message)))
+	p.queues[h.Sum32()%uint32(len(p.queues))] <- message
+}
+
+// Close stop the workers once they handled or abandoned their queued messages
+func (p *WsWorkerPool) Close() {
+	if !p.drain {
+		atomic.StoreInt32(&p.abandoned, 1)
+	}
+	for _, q := range p.queues {
+		close(q)
+	}
+	p.wg.Wait()
+}
+
+func (p *WsWorkerPool) work(queue chan []byte, handler func(message []byte), onPanic func(err *WsHandlerPanicError)) {
+	defer p.wg.Done()
+	for message := range queue {
+		if atomic.LoadInt32(&p.abandoned) == 1 {
+			continue
+		}
+		p.handle(message, handler, onPanic)
+	}
+}
+
+func (p *WsWorkerPool) handle(message []byte, handler func(message []byte), onPanic func(err *WsHandlerPanicError)) {
+	defer func() {
+		if v := recover(); v != nil && onPanic != nil {
+			onPanic(&WsHandlerPanicError{Key: p.key(message), Value: v})
+		}
+	}()
+	handler(message)
+}
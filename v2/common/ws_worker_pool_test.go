@@ -0,0 +1,117 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombinedStreamSymbol(t *testing.T) {
+	r := require.New(t)
+	r.Equal("btcusdt", CombinedStreamSymbol([]byte(`{"stream":"btcusdt@aggTrade","data":{}}`)))
+	r.Equal("", CombinedStreamSymbol([]byte(`{"e":"aggTrade"}`)))
+	r.Equal("", CombinedStreamSymbol([]byte(`not json`)))
+}
+
+func TestWsWorkerPoolOrderPerKey(t *testing.T) {
+	r := require.New(t)
+	const symbols = 300
+	const events = 50
+	var mu sync.Mutex
+	received := make(map[string][]int, symbols)
+	p := NewWsWorkerPool(WsWorkerPoolConfig{Workers: 8, QueueSize: 4, Drain: true}, func(message []byte) {
+		var event struct {
+			Stream string `json:"stream"`
+			Seq    int    `json:"seq"`
+		}
+		if err := json.Unmarshal(message, &event); err != nil {
+			panic(err)
+		}
+		if event.Seq%7 == 0 {
+			time.Sleep(time.Microsecond)
+		}
+		mu.Lock()
+		received[event.Stream] = append(received[event.Stream], event.Seq)
+		mu.Unlock()
+	}, func(err *WsHandlerPanicError) {
+		t.Error(err)
+	})
+	// interleave the events of every symbol
+	for seq := 0; seq < events; seq++ {
+		for s := 0; s < symbols; s++ {
+			p.Push([]byte(fmt.Sprintf(`{"stream":"sym%d@aggTrade", "seq": %d}`, s, seq)))
+		}
+	}
+	p.Close()
+
+	r.Len(received, symbols)
+	for symbol, seqs := range received {
+		r.Len(seqs, events, symbol)
+		for i, seq := range seqs {
+			r.Equal(i, seq, symbol)
+		}
+	}
+}
+
+func TestWsWorkerPoolPanic(t *testing.T) {
+	r := require.New(t)
+	var mu sync.Mutex
+	var panics []*WsHandlerPanicError
+	var handled []string
+	p := NewWsWorkerPool(WsWorkerPoolConfig{Workers: 2, Key: func(message []byte) string {
+		return strings.Split(string(message), ":")[0]
+	}, Drain: true}, func(message []byte) {
+		if strings.HasSuffix(string(message), "panic") {
+			panic("boom")
+		}
+		mu.Lock()
+		handled = append(handled, string(message))
+		mu.Unlock()
+	}, func(err *WsHandlerPanicError) {
+		mu.Lock()
+		panics = append(panics, err)
+		mu.Unlock()
+	})
+	for i := 0; i < 10; i++ {
+		p.Push([]byte("a:" + strconv.Itoa(i)))
+		p.Push([]byte("b:" + strconv.Itoa(i)))
+	}
+	p.Push([]byte("a:panic"))
+	p.Push([]byte("a:10"))
+	p.Close()
+
+	r.Len(handled, 21)
+	r.Len(panics, 1)
+	r.Equal("a", panics[0].Key)
+	r.Equal(`ws handler panic on key "a": boom`, panics[0].Error())
+}
+
+func TestWsWorkerPoolAbandon(t *testing.T) {
+	r := require.New(t)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handled := 0
+	p := NewWsWorkerPool(WsWorkerPoolConfig{Workers: 1, QueueSize: 10}, func(message []byte) {
+		if handled == 0 {
+			close(started)
+			<-release
+		}
+		handled++
+	}, nil)
+	for i := 0; i < 5; i++ {
+		p.Push([]byte(`{"stream":"btcusdt@aggTrade"}`))
+	}
+	<-started
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+	p.Close()
+	r.Equal(1, handled)
+}
@@ -0,0 +1,129 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/delivery"
+	"github.com/Bot-Hive-Trading/go-binance/v2/futures"
+)
+
+// Environment variables read by ConfigFromEnv
+const (
+	EnvAPIKey    = "BINANCE_API_KEY"
+	EnvSecretKey = "BINANCE_SECRET_KEY"
+	EnvTestnet   = "BINANCE_TESTNET"
+	EnvBaseURL   = "BINANCE_BASE_URL"
+)
+
+// Config define the credentials and endpoint of a client, use one file or set of environment
+// variables per environment
+type Config struct {
+	APIKey    string `json:"api_key" yaml:"api_key"`
+	SecretKey string `json:"secret_key" yaml:"secret_key"`
+	// Testnet use the testnet Rest endpoints of the client instead of production. The websocket
+	// streams are not served by the clients and stay on production, set the UseTestnet variable
+	// of the binance, futures or delivery package to switch them.
+	Testnet bool `json:"testnet" yaml:"testnet"`
+	// BaseURL override the endpoint of the client, when set
+	BaseURL string `json:"base_url" yaml:"base_url"`
+}
+
+// LoadConfig read a config from a JSON file, or a YAML file when its extension is .yaml or .yml
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := new(Config)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, cfg)
+	default:
+		err = json.Unmarshal(data, cfg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return cfg, cfg.validate()
+}
+
+// ConfigFromEnv read a config from the BINANCE_API_KEY, BINANCE_SECRET_KEY, BINANCE_TESTNET
+// and BINANCE_BASE_URL environment variables, the last two are optional
+func ConfigFromEnv() (*Config, error) {
+	cfg := &Config{
+		APIKey:    os.Getenv(EnvAPIKey),
+		SecretKey: os.Getenv(EnvSecretKey),
+		BaseURL:   os.Getenv(EnvBaseURL),
+	}
+	if v := os.Getenv(EnvTestnet); v != "" {
+		testnet, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", EnvTestnet, err)
+		}
+		cfg.Testnet = testnet
+	}
+	return cfg, cfg.validate()
+}
+
+func (cfg *Config) validate() error {
+	if cfg.APIKey == "" || cfg.SecretKey == "" {
+		return errors.New("config: api key and secret key are required")
+	}
+	return nil
+}
+
+// NewClient initialize a spot client from the config
+func (cfg *Config) NewClient(opts ...ClientOption) *Client {
+	c := NewClient(cfg.APIKey, cfg.SecretKey, opts...)
+	c.BaseURL = cfg.baseURL(baseAPITestnetURL, c.BaseURL)
+	return c
+}
+
+// NewFuturesClient initialize a futures client from the config
+func (cfg *Config) NewFuturesClient(opts ...futures.ClientOption) *futures.Client {
+	c := futures.NewClient(cfg.APIKey, cfg.SecretKey, opts...)
+	c.BaseURL = cfg.baseURL(futures.BaseAPITestnetURL, c.BaseURL)
+	return c
+}
+
+// NewDeliveryClient initialize a delivery client from the config
+func (cfg *Config) NewDeliveryClient(opts ...delivery.ClientOption) *delivery.Client {
+	c := delivery.NewClient(cfg.APIKey, cfg.SecretKey, opts...)
+	c.BaseURL = cfg.baseURL(delivery.BaseAPITestnetURL, c.BaseURL)
+	return c
+}
+
+func (cfg *Config) baseURL(testnet, current string) string {
+	if cfg.BaseURL != "" {
+		return cfg.BaseURL
+	}
+	if cfg.Testnet {
+		return testnet
+	}
+	return current
+}
+
+// NewClientFromConfig initialize a spot client from a config file, see LoadConfig
+func NewClientFromConfig(configPath string, opts ...ClientOption) (*Client, error) {
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.NewClient(opts...), nil
+}
+
+// NewClientFromEnv initialize a spot client from the environment, see ConfigFromEnv
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.NewClient(opts...), nil
+}
@@ -0,0 +1,79 @@
+package binance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/delivery"
+	"github.com/Bot-Hive-Trading/go-binance/v2/futures"
+)
+
+func writeConfig(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestNewClientFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		baseURL string
+	}{
+		{"json", "binance.json", `{"api_key": "key", "secret_key": "secret"}`, baseAPIMainURL},
+		{"json testnet", "binance.json", `{"api_key": "key", "secret_key": "secret", "testnet": true}`, baseAPITestnetURL},
+		{"yaml", "binance.yaml", "api_key: key\nsecret_key: secret\n", baseAPIMainURL},
+		{"yml base url", "binance.yml", "api_key: key\nsecret_key: secret\ntestnet: true\nbase_url: https://example.com\n", "https://example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			c, err := NewClientFromConfig(writeConfig(t, tt.file, tt.content))
+			r.NoError(err)
+			r.Equal("key", c.APIKey)
+			r.Equal("secret", c.SecretKey)
+			r.Equal(tt.baseURL, c.BaseURL)
+		})
+	}
+}
+
+func TestNewClientFromConfigError(t *testing.T) {
+	r := require.New(t)
+	_, err := NewClientFromConfig(filepath.Join(t.TempDir(), "missing.json"))
+	r.Error(err)
+	_, err = NewClientFromConfig(writeConfig(t, "binance.json", `{"api_key": `))
+	r.Error(err)
+	_, err = NewClientFromConfig(writeConfig(t, "binance.yaml", "api_key: key\n"))
+	r.EqualError(err, "config: api key and secret key are required")
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	r := require.New(t)
+	t.Setenv(EnvAPIKey, "key")
+	t.Setenv(EnvSecretKey, "secret")
+	c, err := NewClientFromEnv()
+	r.NoError(err)
+	r.Equal("key", c.APIKey)
+	r.Equal("secret", c.SecretKey)
+	r.Equal(baseAPIMainURL, c.BaseURL)
+
+	t.Setenv(EnvTestnet, "true")
+	cfg, err := ConfigFromEnv()
+	r.NoError(err)
+	r.Equal(baseAPITestnetURL, cfg.NewClient().BaseURL)
+	r.Equal(futures.BaseAPITestnetURL, cfg.NewFuturesClient().BaseURL)
+	r.Equal(delivery.BaseAPITestnetURL, cfg.NewDeliveryClient().BaseURL)
+
+	t.Setenv(EnvTestnet, "maybe")
+	_, err = ConfigFromEnv()
+	r.Error(err)
+
+	t.Setenv(EnvTestnet, "")
+	t.Setenv(EnvSecretKey, "")
+	_, err = NewClientFromEnv()
+	r.Error(err)
+}
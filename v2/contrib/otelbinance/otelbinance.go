@@ -0,0 +1,136 @@
+// Package otelbinance maps the go-binance request and websocket hooks to OpenTelemetry spans and metrics.
+//
+// It lives in its own module so the core SDK keeps no OpenTelemetry dependency:
+//
+//	hook, err := otelbinance.NewHook(otel.GetTracerProvider(), otel.GetMeterProvider())
+//	client.RequestHook = hook
+//	binance.WsHook = hook
+package otelbinance
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+)
+
+const instrumentationName = "github.com/Bot-Hive-Trading/go-binance/v2/contrib/otelbinance"
+
+// Attribute keys
+const (
+	MethodKey     = attribute.Key("http.request.method")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+	EndpointKey   = attribute.Key("binance.endpoint")
+	UsedWeightKey = attribute.Key("binance.used_weight")
+	StreamKey     = attribute.Key("binance.stream")
+)
+
+// Hook implement common.RequestHook and common.WsHook with OpenTelemetry
+type Hook struct {
+	tracer trace.Tracer
+
+	requestDuration metric.Float64Histogram
+	usedWeight      metric.Int64Gauge
+
+	wsMessages       metric.Int64Counter
+	wsBytes          metric.Int64Counter
+	wsHandlerLatency metric.Float64Histogram
+	wsConnects       metric.Int64Counter
+	wsDisconnects    metric.Int64Counter
+}
+
+var (
+	_ common.RequestHook = (*Hook)(nil)
+	_ common.WsHook      = (*Hook)(nil)
+)
+
+// NewHook init a hook creating spans from tp and instruments from mp
+func NewHook(tp trace.TracerProvider, mp metric.MeterProvider) (*Hook, error) {
+	meter := mp.Meter(instrumentationName)
+	h := &Hook{tracer: tp.Tracer(instrumentationName)}
+	var err error
+	if h.requestDuration, err = meter.Float64Histogram("binance.request.duration",
+		metric.WithUnit("s"), metric.WithDescription("Duration of REST requests")); err != nil {
+		return nil, err
+	}
+	if h.usedWeight, err = meter.Int64Gauge("binance.request.used_weight",
+		metric.WithDescription("Request weight used in the current window")); err != nil {
+		return nil, err
+	}
+	if h.wsMessages, err = meter.Int64Counter("binance.ws.messages",
+		metric.WithDescription("Websocket messages received")); err != nil {
+		return nil, err
+	}
+	if h.wsBytes, err = meter.Int64Counter("binance.ws.bytes",
+		metric.WithUnit("By"), metric.WithDescription("Websocket bytes received")); err != nil {
+		return nil, err
+	}
+	if h.wsHandlerLatency, err = meter.Float64Histogram("binance.ws.handler.duration",
+		metric.WithUnit("s"), metric.WithDescription("Time spent in websocket message handlers")); err != nil {
+		return nil, err
+	}
+	if h.wsConnects, err = meter.Int64Counter("binance.ws.connects",
+		metric.WithDescription("Websocket connections, more than one per stream means reconnects")); err != nil {
+		return nil, err
+	}
+	if h.wsDisconnects, err = meter.Int64Counter("binance.ws.disconnects",
+		metric.WithDescription("Websocket disconnections")); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// OnRequestStart start a client span for the request
+func (h *Hook) OnRequestStart(ctx context.Context, req *common.RequestInfo) context.Context {
+	ctx, _ = h.tracer.Start(ctx, req.Method+" "+req.Endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(req.Start),
+		trace.WithAttributes(MethodKey.String(req.Method), EndpointKey.String(req.Endpoint)),
+	)
+	return ctx
+}
+
+// OnRequestEnd end the span of the request and record its duration and used weight
+func (h *Hook) OnRequestEnd(ctx context.Context, req *common.RequestInfo, res *common.ResponseInfo) {
+	attrs := []attribute.KeyValue{
+		MethodKey.String(req.Method),
+		EndpointKey.String(req.Endpoint),
+		StatusCodeKey.Int(res.StatusCode),
+	}
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(StatusCodeKey.Int(res.StatusCode), UsedWeightKey.Int64(res.UsedWeight))
+	if res.Err != nil {
+		span.RecordError(res.Err)
+		span.SetStatus(codes.Error, res.Err.Error())
+	}
+	span.End(trace.WithTimestamp(req.Start.Add(res.Duration)))
+
+	h.requestDuration.Record(ctx, res.Duration.Seconds(), metric.WithAttributes(attrs...))
+	if res.UsedWeight > 0 {
+		h.usedWeight.Record(ctx, res.UsedWeight)
+	}
+}
+
+// OnConnect count a websocket connection
+func (h *Hook) OnConnect(endpoint string) {
+	h.wsConnects.Add(context.Background(), 1, metric.WithAttributes(StreamKey.String(endpoint)))
+}
+
+// OnMessage count a websocket message and record the handler latency
+func (h *Hook) OnMessage(endpoint string, size int, handlerLatency time.Duration) {
+	ctx := context.Background()
+	attrs := metric.WithAttributes(StreamKey.String(endpoint))
+	h.wsMessages.Add(ctx, 1, attrs)
+	h.wsBytes.Add(ctx, int64(size), attrs)
+	h.wsHandlerLatency.Record(ctx, handlerLatency.Seconds(), attrs)
+}
+
+// OnDisconnect count a websocket disconnection
+func (h *Hook) OnDisconnect(endpoint string, err error) {
+	h.wsDisconnects.Add(context.Background(), 1, metric.WithAttributes(StreamKey.String(endpoint)))
+}
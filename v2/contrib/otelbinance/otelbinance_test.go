@@ -0,0 +1,65 @@
+package otelbinance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+)
+
+func TestHook(t *testing.T) {
+	r := require.New(t)
+	spans := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	h, err := NewHook(tp, mp)
+	r.NoError(err)
+
+	req := &common.RequestInfo{Method: "GET", Endpoint: "/fapi/v2/balance", Start: time.Now()}
+	ctx := h.OnRequestStart(context.Background(), req)
+	h.OnRequestEnd(ctx, req, &common.ResponseInfo{
+		StatusCode: 418,
+		Duration:   20 * time.Millisecond,
+		UsedWeight: 1200,
+		Err:        errors.New("teapot"),
+	})
+	h.OnConnect("wss://fstream.binance.com/ws/btcusdt@aggTrade")
+	h.OnMessage("wss://fstream.binance.com/ws/btcusdt@aggTrade", 120, time.Millisecond)
+	h.OnDisconnect("wss://fstream.binance.com/ws/btcusdt@aggTrade", errors.New("closed"))
+
+	ended := spans.Ended()
+	r.Len(ended, 1)
+	r.Equal("GET /fapi/v2/balance", ended[0].Name())
+	r.Equal(otelcodes.Error, ended[0].Status().Code)
+
+	var rm metricdata.ResourceMetrics
+	r.NoError(reader.Collect(context.Background(), &rm))
+	names := map[string]bool{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			names[m.Name] = true
+		}
+	}
+	for _, n := range []string{
+		"binance.request.duration",
+		"binance.request.used_weight",
+		"binance.ws.messages",
+		"binance.ws.bytes",
+		"binance.ws.handler.duration",
+		"binance.ws.connects",
+		"binance.ws.disconnects",
+	} {
+		r.True(names[n], n)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -69,6 +70,9 @@ const (
 	baseApiTestnetUrl = "https://testnet.binancefuture.com"
 )
 
+// BaseAPITestnetURL is the base endpoint of the testnet Rest API
+const BaseAPITestnetURL = baseApiTestnetUrl
+
 // Global enums
 const (
 	SideTypeBuy  SideType = "BUY"
@@ -185,8 +189,8 @@ func getApiEndpoint() string {
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
-func NewClient(apiKey, secretKey string) *Client {
-	return &Client{
+func NewClient(apiKey, secretKey string, opts ...ClientOption) *Client {
+	c := &Client{
 		APIKey:     apiKey,
 		SecretKey:  secretKey,
 		BaseURL:    getApiEndpoint(),
@@ -194,6 +198,7 @@ func NewClient(apiKey, secretKey string) *Client {
 		HTTPClient: http.DefaultClient,
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
+	return c.applyOptions(opts)
 }
 
 type doFunc func(req *http.Request) (*http.Response, error)
@@ -207,14 +212,65 @@ type Client struct {
 	HTTPClient *http.Client
 	Debug      bool
 	Logger     *log.Logger
-	TimeOffset int64
-	do         doFunc
+	// StructuredLogger receives the request logs with secrets redacted,
+	// Logger is used in debug mode when it is not set
+	StructuredLogger common.Logger
+	// RequestHook is called around every request, for tracing and metrics
+	RequestHook common.RequestHook
+	TimeOffset  int64
+	do          doFunc
+
+	debugWriter    io.Writer
+	debugBodyLimit int
+	debugOut       *common.DebugWriter
+}
+
+// ClientOption define option type for client
+type ClientOption func(*Client)
+
+// WithDebug write each raw request url and body and each response body to w, prefixed with a timestamp.
+// Signatures and listen keys are redacted and bodies are truncated, see WithDebugBodyLimit.
+func WithDebug(w io.Writer) ClientOption {
+	return func(c *Client) {
+		c.debugWriter = w
+	}
+}
+
+// WithDebugBodyLimit set the number of body bytes written by WithDebug, common.DefaultDebugBodyLimit by default
+func WithDebugBodyLimit(limit int) ClientOption {
+	return func(c *Client) {
+		c.debugBodyLimit = limit
+	}
+}
+
+func (c *Client) applyOptions(opts []ClientOption) *Client {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debugWriter != nil {
+		c.debugOut = common.NewDebugWriter(c.debugWriter, c.debugBodyLimit)
+	}
+	return c
 }
 
-func (c *Client) debug(format string, v ...interface{}) {
-	if c.Debug {
-		c.Logger.Printf(format, v...)
+// logger return the structured logger of the client
+func (c *Client) logger() common.Logger {
+	if c.StructuredLogger != nil {
+		return c.StructuredLogger
 	}
+	if c.Debug && c.Logger != nil {
+		return common.NewStdLogger(c.Logger)
+	}
+	return common.NopLogger
+}
+
+func (c *Client) debug(msg string, keyvals ...interface{}) {
+	c.logger().Debug(msg, keyvals...)
+}
+
+// debugEnabled report whether debug lines are logged, callers skip building costly values otherwise
+func (c *Client) debugEnabled() bool {
+	return c.logger() != common.NopLogger
 }
 
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
@@ -267,7 +323,9 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
+	if c.debugEnabled() {
+		c.debug("parsed request", "url", common.Redact(fullURL), "body", common.Redact(bodyString))
+	}
 
 	r.fullURL = fullURL
 	r.header = header
@@ -276,6 +334,19 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	var res *http.Response
+	if c.RequestHook != nil {
+		info := &common.RequestInfo{Method: r.method, Endpoint: r.endpoint, Start: time.Now()}
+		ctx = c.RequestHook.OnRequestStart(ctx, info)
+		defer func() {
+			out := &common.ResponseInfo{Duration: time.Since(info.Start), Err: err}
+			if res != nil {
+				out.StatusCode = res.StatusCode
+				out.UsedWeight = common.UsedWeight(res.Header)
+			}
+			c.RequestHook.OnRequestEnd(ctx, info, out)
+		}()
+	}
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -286,19 +357,28 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v", req)
+	if c.debugOut != nil {
+		c.debugOut.Request(r.method, r.fullURL, r.form.Encode())
+	}
+	if c.debugEnabled() {
+		c.debug("request", "method", req.Method, "url", common.Redact(r.fullURL), "header", common.RedactHeader(req.Header))
+	}
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
-	res, err := f(req)
+	res, err = f(req)
 	if err != nil {
+		c.logger().Error("request failed", "method", req.Method, "url", common.Redact(r.fullURL), "error", err)
 		return []byte{}, err
 	}
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
 		return []byte{}, err
 	}
+	if c.debugOut != nil {
+		c.debugOut.Response(res.StatusCode, r.fullURL, data)
+	}
 	defer func() {
 		cerr := res.Body.Close()
 		// Only overwrite the retured error if the original error was nil and an
@@ -307,16 +387,17 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
+	if c.debugEnabled() {
+		c.debug("response", "url", common.Redact(r.fullURL), "status", res.StatusCode, "header", res.Header, "body", common.Redact(string(data)))
+	}
 
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
 		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
+			c.debug("failed to unmarshal json", "error", e)
 		}
+		c.logger().Error("api error", "method", req.Method, "url", common.Redact(r.fullURL), "status", res.StatusCode, "code", apiErr.Code, "msg", apiErr.Message)
 		return nil, apiErr
 	}
 	return data, nil
@@ -1560,3 +1560,17 @@ func (s *websocketServiceTestSuite) assertOrderTradeUpdate(e, a WsOrderTradeUpda
 	r.Equal(e.CallbackRate, a.CallbackRate, "CallbackRate")
 	r.Equal(e.RealizedPnL, a.RealizedPnL, "RealizedPnL")
 }
+
+func (s *websocketServiceTestSuite) TestWsServeOptions() {
+	var got *WsConfig
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		got = cfg
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	_, _, err := WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {},
+		WithKeepalive(true), WithKeepaliveTimeout(time.Second), WithMaxMessageSize(1024))
+	s.r().NoError(err)
+	s.r().True(got.Keepalive)
+	s.r().Equal(time.Second, got.KeepaliveTimeout)
+	s.r().Equal(int64(1024), got.MaxMessageSize)
+}
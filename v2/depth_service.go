@@ -2,7 +2,10 @@ package binance
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 )
@@ -26,6 +29,15 @@ func (s *DepthService) Limit(limit int) *DepthService {
 	return s
 }
 
+// Weight return the request weight of the request, which scales with the limit
+func (s *DepthService) Weight() int {
+	params := url.Values{}
+	if s.limit != nil {
+		params.Set("limit", strconv.Itoa(*s.limit))
+	}
+	return s.c.EstimateWeight(http.MethodGet, "/api/v3/depth", params)
+}
+
 // Do send request
 func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *DepthResponse, err error) {
 	r := &request{
@@ -40,34 +52,68 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	if err != nil {
 		return nil, err
 	}
-	j, err := newJSON(data)
+	res = new(DepthResponse)
+	err = json.Unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
-	res = new(DepthResponse)
-	res.LastUpdateID = j.Get("lastUpdateId").MustInt64()
-	bidsLen := len(j.Get("bids").MustArray())
-	res.Bids = make([]Bid, bidsLen)
-	for i := 0; i < bidsLen; i++ {
-		item := j.Get("bids").GetIndex(i)
-		res.Bids[i] = Bid{
-			Price:    item.GetIndex(0).MustString(),
-			Quantity: item.GetIndex(1).MustString(),
-		}
+	if res.Bids == nil {
+		res.Bids = []Bid{}
 	}
-	asksLen := len(j.Get("asks").MustArray())
-	res.Asks = make([]Ask, asksLen)
-	for i := 0; i < asksLen; i++ {
-		item := j.Get("asks").GetIndex(i)
-		res.Asks[i] = Ask{
-			Price:    item.GetIndex(0).MustString(),
-			Quantity: item.GetIndex(1).MustString(),
-		}
+	if res.Asks == nil {
+		res.Asks = []Ask{}
 	}
 	return res, nil
 }
 
-// DepthResponse define depth info with bids and asks
+// OrderBookLimits are the limits accepted by GetOrderBookService
+var OrderBookLimits = []int{5, 10, 20, 50, 100, 500, 1000, 5000}
+
+// GetOrderBookService show the order book of a symbol like DepthService, but the limit must be
+// one of OrderBookLimits
+type GetOrderBookService struct {
+	c      *Client
+	symbol string
+	limit  *int
+}
+
+// Symbol set symbol
+func (s *GetOrderBookService) Symbol(symbol string) *GetOrderBookService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit
+func (s *GetOrderBookService) Limit(limit int) *GetOrderBookService {
+	s.limit = &limit
+	return s
+}
+
+// Weight return the request weight of the request, which scales with the limit
+func (s *GetOrderBookService) Weight() int {
+	depth := &DepthService{c: s.c, symbol: s.symbol, limit: s.limit}
+	return depth.Weight()
+}
+
+// Do send request
+func (s *GetOrderBookService) Do(ctx context.Context, opts ...RequestOption) (res *DepthResponse, err error) {
+	if s.limit != nil && !validOrderBookLimit(*s.limit) {
+		return nil, fmt.Errorf("%w: limit %d is not one of %v", ErrInvalidParam, *s.limit, OrderBookLimits)
+	}
+	depth := &DepthService{c: s.c, symbol: s.symbol, limit: s.limit}
+	return depth.Do(ctx, opts...)
+}
+
+func validOrderBookLimit(limit int) bool {
+	for _, l := range OrderBookLimits {
+		if l == limit {
+			return true
+		}
+	}
+	return false
+}
+
+// DepthResponse define depth info with bids and asks, the bids and asks are never nil
 type DepthResponse struct {
 	LastUpdateID int64 `json:"lastUpdateId"`
 	Bids         []Bid `json:"bids"`
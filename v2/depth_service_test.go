@@ -61,6 +61,55 @@ func (s *depthServiceTestSuite) TestDepth() {
 	s.assertDepthResponseEqual(e, res)
 }
 
+func (s *depthServiceTestSuite) TestGetOrderBook() {
+	data := []byte(`{
+        "lastUpdateId": 1027024,
+        "bids": [],
+        "asks": [["4.00000200", "12.00000000"]]
+    }`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	symbol := "LTCBTC"
+	limit := 5
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", symbol).
+			setParam("limit", limit)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetOrderBookService().Symbol(symbol).Limit(limit).Do(newContext())
+	s.r().NoError(err)
+	s.r().NotNil(res.Bids)
+	s.assertDepthResponseEqual(&DepthResponse{
+		LastUpdateID: 1027024,
+		Bids:         []Bid{},
+		Asks:         []Ask{{Price: "4.00000200", Quantity: "12.00000000"}},
+	}, res)
+}
+
+func (s *depthServiceTestSuite) TestGetOrderBookInvalidLimit() {
+	_, err := s.client.NewGetOrderBookService().Symbol("LTCBTC").Limit(3).Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+	s.r().EqualError(err, "invalid parameter: limit 3 is not one of [5 10 20 50 100 500 1000 5000]")
+}
+
+func (s *depthServiceTestSuite) TestDepthWeight() {
+	r := s.r()
+	r.Equal(5, s.client.NewDepthService().Symbol("LTCBTC").Weight())
+	r.Equal(5, s.client.NewGetOrderBookService().Symbol("LTCBTC").Limit(100).Weight())
+	r.Equal(25, s.client.NewGetOrderBookService().Symbol("LTCBTC").Limit(500).Weight())
+	r.Equal(50, s.client.NewGetOrderBookService().Symbol("LTCBTC").Limit(1000).Weight())
+	r.Equal(250, s.client.NewDepthService().Symbol("LTCBTC").Limit(5000).Weight())
+}
+
+func (s *depthServiceTestSuite) TestDepthInvalidLevel() {
+	data := []byte(`{"lastUpdateId": 1027024, "bids": [["4.00000000"]], "asks": []}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {})
+	_, err := s.client.NewDepthService().Symbol("LTCBTC").Do(newContext())
+	s.r().Error(err)
+}
+
 func (s *depthServiceTestSuite) assertDepthResponseEqual(e, a *DepthResponse) {
 	r := s.r()
 	r.Equal(e.LastUpdateID, a.LastUpdateID, "LastUpdateID")
@@ -17,11 +17,15 @@ import (
 // ListDustLogService fetch small amounts of assets exchanged versus BNB
 // See https://binance-docs.github.io/apidocs/spot/en/#dustlog-user_data
 type ListDustLogService struct {
-	c         *Client
-	startTime *int64
-	endTime   *int64
+	c           *Client
+	startTime   *int64
+	endTime     *int64
+	accountType *AccountType
 }
 
+// GetDustLogService fetch small amounts of assets exchanged versus BNB, see ListDustLogService
+type GetDustLogService = ListDustLogService
+
 // StartTime sets the startTime parameter.
 // If present, EndTime MUST be specified. The difference between EndTime - StartTime MUST be between 0-90 days.
 func (s *ListDustLogService) StartTime(startTime int64) *ListDustLogService {
@@ -36,8 +40,15 @@ func (s *ListDustLogService) EndTime(endTime int64) *ListDustLogService {
 	return s
 }
 
+// AccountType sets the accountType parameter, AccountTypeSpot or AccountTypeMargin.
+// The conversions of all the account types are returned when it is not set.
+func (s *ListDustLogService) AccountType(accountType AccountType) *ListDustLogService {
+	s.accountType = &accountType
+	return s
+}
+
 // Do sends the request.
-func (s *ListDustLogService) Do(ctx context.Context) (withdraws *DustResult, err error) {
+func (s *ListDustLogService) Do(ctx context.Context, opts ...RequestOption) (withdraws *DustResult, err error) {
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/sapi/v1/asset/dribblet",
@@ -49,7 +60,10 @@ func (s *ListDustLogService) Do(ctx context.Context) (withdraws *DustResult, err
 	if s.endTime != nil {
 		r.setParam("endTime", *s.endTime)
 	}
-	data, err := s.c.callAPI(ctx, r)
+	if s.accountType != nil {
+		r.setParam("accountType", *s.accountType)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return
 	}
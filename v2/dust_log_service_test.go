@@ -144,6 +144,27 @@ func (s *listDustLogServiceTestSuite) TestListDustLog() {
 	}, &rows[1])
 }
 
+func (s *listDustLogServiceTestSuite) TestListDustLogWithParams() {
+	data := []byte(`{"total": 0, "userAssetDribblets": []}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime":   int64(1615985535000),
+			"endTime":     int64(1616203180000),
+			"accountType": AccountTypeMargin,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListDustLogService().StartTime(1615985535000).EndTime(1616203180000).
+		AccountType(AccountTypeMargin).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Empty(res.UserAssetDribblets)
+}
+
 func (s *listDustLogServiceTestSuite) assertUserAssetDribbletEqual(e, a *UserAssetDribblet) {
 	r := s.r()
 	r.Equal(e.TotalTransferedAmount, a.TotalTransferedAmount, `TotalTransferedAmount`)
@@ -0,0 +1,7 @@
+package binance
+
+import "github.com/Bot-Hive-Trading/go-binance/v2/common"
+
+// ErrInvalidParam is wrapped by the errors returned before sending a request with an invalid parameter,
+// see common.ErrInvalidParam
+var ErrInvalidParam = common.ErrInvalidParam
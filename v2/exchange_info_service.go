@@ -7,10 +7,12 @@ import (
 
 // ExchangeInfoService exchange info service
 type ExchangeInfoService struct {
-	c           *Client
-	symbol      string
-	symbols     []string
-	permissions []string
+	c                  *Client
+	symbol             string
+	symbols            []string
+	permissions        []string
+	symbolStatus       *string
+	showPermissionSets *bool
 }
 
 // Symbol set symbol
@@ -33,6 +35,20 @@ func (s *ExchangeInfoService) Permissions(permissions ...string) *ExchangeInfoSe
 	return s
 }
 
+// SymbolStatus set symbolStatus, only return the symbols in this status: PRE_TRADING, TRADING or HALT,
+// it cannot be combined with symbol or symbols
+func (s *ExchangeInfoService) SymbolStatus(symbolStatus string) *ExchangeInfoService {
+	s.symbolStatus = &symbolStatus
+	return s
+}
+
+// ShowPermissionSets set showPermissionSets, false leaves the permissionSets of the symbols empty
+// to reduce the size of the response
+func (s *ExchangeInfoService) ShowPermissionSets(showPermissionSets bool) *ExchangeInfoService {
+	s.showPermissionSets = &showPermissionSets
+	return s
+}
+
 // Do send request
 func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *ExchangeInfo, err error) {
 	r := &request{
@@ -50,6 +66,12 @@ func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (re
 	if len(s.permissions) != 0 {
 		m["permissions"] = s.permissions
 	}
+	if s.symbolStatus != nil {
+		m["symbolStatus"] = *s.symbolStatus
+	}
+	if s.showPermissionSets != nil {
+		m["showPermissionSets"] = *s.showPermissionSets
+	}
 	r.setParams(m)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -100,6 +122,7 @@ type Symbol struct {
 	IsMarginTradingAllowed     bool                     `json:"isMarginTradingAllowed"`
 	Filters                    []map[string]interface{} `json:"filters"`
 	Permissions                []string                 `json:"permissions"`
+	PermissionSets             [][]string               `json:"permissionSets"`
 }
 
 // LotSizeFilter define lot size filter of symbol
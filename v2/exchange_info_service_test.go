@@ -132,6 +132,38 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 	s.assertMaxNumAlgoOrdersFilterEqual(eMaxNumAlgoOrdersFilter, res.Symbols[0].MaxNumAlgoOrdersFilter())
 }
 
+func (s *exchangeInfoServiceTestSuite) TestExchangeInfoBySymbolStatus() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1539281238296,
+		"symbols": [
+			{
+				"symbol": "ETHBTC",
+				"status": "TRADING",
+				"permissions": [],
+				"permissionSets": [["SPOT", "MARGIN"], ["TRD_GRP_004"]]
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(map[string]interface{}{
+			"permissions":        `["SPOT"]`,
+			"symbolStatus":       "TRADING",
+			"showPermissionSets": true,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewExchangeInfoService().Permissions("SPOT").SymbolStatus("TRADING").
+		ShowPermissionSets(true).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Symbols, 1)
+	r.Equal("TRADING", res.Symbols[0].Status)
+	r.Equal([][]string{{"SPOT", "MARGIN"}, {"TRD_GRP_004"}}, res.Symbols[0].PermissionSets)
+}
+
 func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInfo) {
 	r := s.r()
 
@@ -0,0 +1,125 @@
+package binance
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// FallbackAPIURLs are the alternate REST hosts published by Binance, for use with WithFallbackURLs
+var FallbackAPIURLs = []string{
+	"https://api1.binance.com",
+	"https://api2.binance.com",
+	"https://api3.binance.com",
+	"https://api4.binance.com",
+	"https://api-gcp.binance.com",
+}
+
+// DefaultFailoverCooldown is the time spent on a fallback host before retrying BaseURL
+const DefaultFailoverCooldown = time.Minute
+
+// hostFailover track the host serving the requests of a client
+type hostFailover struct {
+	mu        sync.Mutex
+	fallbacks []string
+	cooldown  time.Duration
+	active    string // fallback host in use, empty for BaseURL
+	since     time.Time
+	last      string
+}
+
+// WithFallbackURLs set the REST hosts tried in order after BaseURL on connection errors or 5xx responses.
+// Only GET requests are retried on another host after a 5xx or an error once sent, the other methods are
+// only retried when the connection could not be established: the outcome of an order sent to a failing
+// host is unknown and sending it again could place it twice.
+// Once failed over, requests stay on the fallback host until the cooldown has elapsed, see WithFailoverCooldown.
+func WithFallbackURLs(urls ...string) ClientOption {
+	return func(c *Client) {
+		c.failover.mu.Lock()
+		c.failover.fallbacks = append([]string{}, urls...)
+		c.failover.mu.Unlock()
+	}
+}
+
+// WithFailoverCooldown set the time spent on a fallback host before retrying BaseURL, DefaultFailoverCooldown by default
+func WithFailoverCooldown(cooldown time.Duration) ClientOption {
+	return func(c *Client) {
+		c.failover.mu.Lock()
+		c.failover.cooldown = cooldown
+		c.failover.mu.Unlock()
+	}
+}
+
+// LastHost return the base URL of the host which answered the last request
+func (c *Client) LastHost() string {
+	c.failover.mu.Lock()
+	defer c.failover.mu.Unlock()
+	return c.failover.last
+}
+
+// hosts return the hosts to try for r, in order
+func (c *Client) hosts(r *request) []string {
+	if r.forceHost != "" {
+		return []string{r.forceHost}
+	}
+	f := &c.failover
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all := append([]string{c.BaseURL}, f.fallbacks...)
+	cooldown := f.cooldown
+	if cooldown <= 0 {
+		cooldown = DefaultFailoverCooldown
+	}
+	if f.active != "" && time.Since(f.since) >= cooldown {
+		f.active = ""
+	}
+	for i, h := range all {
+		if h == f.active {
+			return append(all[i:], all[:i]...)
+		}
+	}
+	return all
+}
+
+// canFailOver report whether a request of method can be sent to another host after err or status code
+func canFailOver(method string, status int, err error) bool {
+	if err == nil && status < http.StatusInternalServerError {
+		return false
+	}
+	if method == http.MethodGet {
+		return true
+	}
+	return err != nil && notSent(err)
+}
+
+// notSent report whether err happened before the request was written, while resolving or dialing the host
+func notSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// failOver switch the requests from a failed host to the next one
+func (c *Client) failOver(from, to string, err error) {
+	f := &c.failover
+	f.mu.Lock()
+	if to == c.BaseURL {
+		f.active = ""
+	} else {
+		f.active = to
+	}
+	f.since = time.Now()
+	f.mu.Unlock()
+	c.logger().Info("failover", "from", from, "to", to, "error", err)
+}
+
+func (c *Client) setLastHost(host string) {
+	c.failover.mu.Lock()
+	c.failover.last = host
+	c.failover.mu.Unlock()
+}
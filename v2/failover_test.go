@@ -0,0 +1,141 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type failoverServer struct {
+	*httptest.Server
+	hits   int32
+	status int32
+}
+
+func newFailoverServer(status int) *failoverServer {
+	s := &failoverServer{status: int32(status)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.hits, 1)
+		w.WriteHeader(int(atomic.LoadInt32(&s.status)))
+		w.Write([]byte(`{"serverTime": 1499827319559}`))
+	}))
+	return s
+}
+
+func TestFailover(t *testing.T) {
+	r := require.New(t)
+	primary := newFailoverServer(http.StatusBadGateway)
+	defer primary.Close()
+	fallback := newFailoverServer(http.StatusOK)
+	defer fallback.Close()
+
+	c := NewClient("key", "secret", WithFallbackURLs(fallback.URL))
+	c.BaseURL = primary.URL
+
+	serverTime, err := c.NewServerTimeService().Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(1499827319559), serverTime)
+	r.Equal(fallback.URL, c.LastHost())
+	r.Equal(int32(1), primary.hits)
+
+	// stay on the fallback host during the cooldown
+	atomic.StoreInt32(&primary.status, http.StatusOK)
+	_, err = c.NewServerTimeService().Do(context.Background())
+	r.NoError(err)
+	r.Equal(fallback.URL, c.LastHost())
+	r.Equal(int32(1), primary.hits)
+
+	// back to BaseURL once the cooldown has elapsed
+	c.applyOptions([]ClientOption{WithFailoverCooldown(time.Nanosecond)})
+	_, err = c.NewServerTimeService().Do(context.Background())
+	r.NoError(err)
+	r.Equal(primary.URL, c.LastHost())
+	r.Equal(int32(2), primary.hits)
+}
+
+func TestFailoverAllHostsDown(t *testing.T) {
+	r := require.New(t)
+	primary := newFailoverServer(http.StatusServiceUnavailable)
+	defer primary.Close()
+	fallback := newFailoverServer(http.StatusBadGateway)
+	defer fallback.Close()
+
+	c := NewClient("key", "secret", WithFallbackURLs(fallback.URL))
+	c.BaseURL = primary.URL
+
+	_, err := c.NewServerTimeService().Do(context.Background())
+	r.Error(err)
+	r.Equal(int32(1), primary.hits)
+	r.Equal(int32(1), fallback.hits)
+	r.Equal(fallback.URL, c.LastHost())
+}
+
+func TestFailoverClientError(t *testing.T) {
+	r := require.New(t)
+	primary := newFailoverServer(http.StatusBadRequest)
+	defer primary.Close()
+	fallback := newFailoverServer(http.StatusOK)
+	defer fallback.Close()
+
+	c := NewClient("key", "secret", WithFallbackURLs(fallback.URL))
+	c.BaseURL = primary.URL
+
+	_, err := c.NewServerTimeService().Do(context.Background())
+	r.Error(err)
+	r.Equal(int32(0), fallback.hits)
+	r.Equal(primary.URL, c.LastHost())
+}
+
+func TestWithForceHost(t *testing.T) {
+	r := require.New(t)
+	primary := newFailoverServer(http.StatusOK)
+	defer primary.Close()
+	forced := newFailoverServer(http.StatusBadGateway)
+	defer forced.Close()
+
+	c := NewClient("key", "secret")
+	c.BaseURL = primary.URL
+
+	_, err := c.NewServerTimeService().Do(context.Background(), WithForceHost(forced.URL))
+	r.Error(err)
+	r.Equal(int32(1), forced.hits)
+	r.Equal(int32(0), primary.hits)
+}
+
+func TestFailoverOrderNotRetried(t *testing.T) {
+	r := require.New(t)
+	primary := newFailoverServer(http.StatusBadGateway)
+	defer primary.Close()
+	fallback := newFailoverServer(http.StatusOK)
+	defer fallback.Close()
+
+	c := NewClient("key", "secret", WithFallbackURLs(fallback.URL))
+	c.BaseURL = primary.URL
+
+	_, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("1").Do(context.Background())
+	r.Error(err)
+	r.Equal(int32(1), primary.hits)
+	r.Equal(int32(0), fallback.hits, "a POST order must not be sent again after a 5xx")
+}
+
+func TestFailoverOrderDialError(t *testing.T) {
+	r := require.New(t)
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	fallback := newFailoverServer(http.StatusOK)
+	defer fallback.Close()
+
+	c := NewClient("key", "secret", WithFallbackURLs(fallback.URL))
+	c.BaseURL = down.URL
+
+	_, err := c.NewCancelOrderService().Symbol("BTCUSDT").OrderID(1).Do(context.Background())
+	r.NoError(err)
+	r.Equal(int32(1), fallback.hits, "a request which could not be sent can be sent to another host")
+	r.Equal(fallback.URL, c.LastHost())
+}
@@ -6,7 +6,7 @@ import (
 	"net/http"
 )
 
-// GetBalanceService get account balance
+// GetBalanceService get account balance, lighter than GetAccountService when only the balances are needed
 type GetBalanceService struct {
 	c *Client
 }
@@ -39,8 +39,16 @@ type Balance struct {
 	CrossUnPnl         string `json:"crossUnPnl"`
 	AvailableBalance   string `json:"availableBalance"`
 	MaxWithdrawAmount  string `json:"maxWithdrawAmount"`
+	MarginAvailable    bool   `json:"marginAvailable"`
+	UpdateTime         int64  `json:"updateTime"`
 }
 
+// GetFuturesBalanceService get account balance, see GetBalanceService
+type GetFuturesBalanceService = GetBalanceService
+
+// FuturesBalance define user balance of your account, see Balance
+type FuturesBalance = Balance
+
 // GetAccountService get account info
 type GetAccountService struct {
 	c *Client
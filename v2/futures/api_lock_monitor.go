@@ -0,0 +1,114 @@
+package futures
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultApiLockMonitorInterval is the poll interval of an ApiLockMonitor created without a positive interval
+const DefaultApiLockMonitorInterval = time.Minute
+
+// ApiLockMonitor poll the API trading status of the account and alert when it gets locked
+type ApiLockMonitor struct {
+	c          *Client
+	interval   time.Duration
+	onLock     func(status FuturesApiTradingStatus)
+	errHandler ErrHandler
+
+	mu     sync.Mutex
+	locked bool
+	stopC  chan struct{}
+	doneC  chan struct{}
+}
+
+// NewApiLockMonitor init an API lock monitor polling the trading status every interval,
+// DefaultApiLockMonitorInterval when it is not positive. onLock is called when the status goes
+// from unlocked to locked, see FuturesApiTradingStatus.Locked
+func NewApiLockMonitor(client *Client, interval time.Duration, onLock func(status FuturesApiTradingStatus)) *ApiLockMonitor {
+	if interval <= 0 {
+		interval = DefaultApiLockMonitorInterval
+	}
+	return &ApiLockMonitor{c: client, interval: interval, onLock: onLock}
+}
+
+// OnError set the handler of the polling errors, they are ignored by default
+func (m *ApiLockMonitor) OnError(errHandler ErrHandler) *ApiLockMonitor {
+	m.errHandler = errHandler
+	return m
+}
+
+// Start poll the trading status now and then every interval until Stop is called,
+// it does nothing if the monitor is already started
+func (m *ApiLockMonitor) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopC != nil {
+		return
+	}
+	m.stopC = make(chan struct{})
+	m.doneC = make(chan struct{})
+	go m.run(m.stopC, m.doneC)
+}
+
+// Stop stop polling and wait for the pending poll to return
+func (m *ApiLockMonitor) Stop() {
+	m.mu.Lock()
+	stopC, doneC := m.stopC, m.doneC
+	m.stopC, m.doneC = nil, nil
+	m.mu.Unlock()
+	if stopC == nil {
+		return
+	}
+	close(stopC)
+	<-doneC
+}
+
+// Locked tell if the last polled status was locked
+func (m *ApiLockMonitor) Locked() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.locked
+}
+
+func (m *ApiLockMonitor) run(stopC, doneC chan struct{}) {
+	defer close(doneC)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stopC:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		m.poll(ctx)
+		select {
+		case <-stopC:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *ApiLockMonitor) poll(ctx context.Context) {
+	status, err := m.c.NewGetFuturesApiTradingStatusService().Do(ctx)
+	if err != nil {
+		if m.errHandler != nil && ctx.Err() == nil {
+			m.errHandler(err)
+		}
+		return
+	}
+	locked := status.Locked()
+	m.mu.Lock()
+	wasLocked := m.locked
+	m.locked = locked
+	m.mu.Unlock()
+	if locked && !wasLocked && m.onLock != nil {
+		m.onLock(*status)
+	}
+}
@@ -0,0 +1,87 @@
+package futures
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiLockMonitor(t *testing.T) {
+	r := require.New(t)
+	// unlocked, locked, locked, unlocked, locked
+	states := []bool{false, true, true, false, true}
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&polls, 1)) - 1
+		if i >= len(states) {
+			i = len(states) - 1
+		}
+		fmt.Fprintf(w, `{"isLocked": %t, "plannedRecoverTime": 0, "updateTime": %d}`, states[i], i)
+	}))
+	defer server.Close()
+
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	alerts := make(chan FuturesApiTradingStatus, 10)
+	m := NewApiLockMonitor(c, time.Millisecond, func(status FuturesApiTradingStatus) {
+		alerts <- status
+	})
+	m.Start()
+	m.Start()
+	r.Eventually(func() bool {
+		return atomic.LoadInt32(&polls) >= int32(len(states)+1)
+	}, time.Second, time.Millisecond)
+	m.Stop()
+	m.Stop()
+	close(alerts)
+
+	var updates []int64
+	for status := range alerts {
+		r.True(status.IsLocked)
+		updates = append(updates, status.UpdateTime)
+	}
+	r.Equal([]int64{1, 4}, updates)
+	r.True(m.Locked())
+}
+
+func TestApiLockMonitorError(t *testing.T) {
+	r := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code": -1022, "msg": "Signature for this request is not valid."}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	errC := make(chan error, 1)
+	m := NewApiLockMonitor(c, time.Hour, func(status FuturesApiTradingStatus) {
+		t.Error("unexpected lock alert")
+	}).OnError(func(err error) {
+		errC <- err
+	})
+	m.Start()
+	defer m.Stop()
+	select {
+	case err := <-errC:
+		r.Error(err)
+	case <-time.After(time.Second):
+		t.Fatal("no error reported")
+	}
+	r.False(m.Locked())
+}
+
+func TestApiLockMonitorDefaultInterval(t *testing.T) {
+	r := require.New(t)
+	c := NewClient("key", "secret")
+	r.Equal(DefaultApiLockMonitorInterval, NewApiLockMonitor(c, 0, nil).interval)
+	r.Equal(DefaultApiLockMonitorInterval, NewApiLockMonitor(c, -time.Second, nil).interval)
+	r.Equal(time.Second, NewApiLockMonitor(c, time.Second, nil).interval)
+}
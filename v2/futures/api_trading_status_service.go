@@ -0,0 +1,92 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetFuturesApiTradingStatusService get the futures trading quantitative rules indicators,
+// telling if the API trading of the account is locked
+type GetFuturesApiTradingStatusService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetFuturesApiTradingStatusService) Symbol(symbol string) *GetFuturesApiTradingStatusService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetFuturesApiTradingStatusService) Do(ctx context.Context, opts ...RequestOption) (res *FuturesApiTradingStatus, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/apiTradingStatus",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(FuturesApiTradingStatus)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAPITradingStatusService get the futures trading quantitative rules indicators, see GetFuturesApiTradingStatusService
+type GetAPITradingStatusService = GetFuturesApiTradingStatusService
+
+// FuturesApiTradingStatus define futures API trading status
+type FuturesApiTradingStatus struct {
+	IsLocked           bool                   `json:"isLocked"`
+	PlannedRecoverTime int64                  `json:"plannedRecoverTime"`
+	TriggerCondition   map[string]int         `json:"triggerCondition"`
+	Indicators         map[string][]Indicator `json:"indicators"` // keyed by symbol, or "ACCOUNT" for account level indicators
+	UpdateTime         int64                  `json:"updateTime"`
+}
+
+// Indicator define a quantitative rules indicator
+type Indicator struct {
+	IsLocked           bool    `json:"isLocked"`
+	PlannedRecoverTime int64   `json:"plannedRecoverTime"`
+	Indicator          string  `json:"indicator"` // UFR, IFER, GCR, DR or TMV
+	Value              float64 `json:"value"`
+	TriggerValue       float64 `json:"triggerValue"`
+}
+
+// Locked tell if API trading is locked, either for the whole account or for one of the indicators
+func (s *FuturesApiTradingStatus) Locked() bool {
+	if s.IsLocked {
+		return true
+	}
+	for _, indicators := range s.Indicators {
+		for _, i := range indicators {
+			if i.IsLocked {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NearLock return the indicators, keyed like Indicators, whose value reached the given fraction
+// of their trigger value, e.g. 0.8 to be warned before the account is locked
+func (s *FuturesApiTradingStatus) NearLock(fraction float64) map[string][]Indicator {
+	res := make(map[string][]Indicator)
+	for key, indicators := range s.Indicators {
+		for _, i := range indicators {
+			if i.IsLocked || (i.TriggerValue > 0 && i.Value >= i.TriggerValue*fraction) {
+				res[key] = append(res[key], i)
+			}
+		}
+	}
+	return res
+}
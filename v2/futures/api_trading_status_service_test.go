@@ -0,0 +1,96 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type apiTradingStatusServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestApiTradingStatusService(t *testing.T) {
+	suite.Run(t, new(apiTradingStatusServiceTestSuite))
+}
+
+func (s *apiTradingStatusServiceTestSuite) TestGetApiTradingStatus() {
+	data := []byte(`{
+		"indicators": {
+			"BTCUSDT": [
+				{
+					"isLocked": true,
+					"plannedRecoverTime": 1545741270000,
+					"indicator": "UFR",
+					"value": 0.05,
+					"triggerValue": 0.995
+				}
+			],
+			"ACCOUNT": [
+				{
+					"indicator": "TMV",
+					"value": 10,
+					"triggerValue": 1
+				}
+			]
+		},
+		"updateTime": 1545741270000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", symbol)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetFuturesApiTradingStatusService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &FuturesApiTradingStatus{
+		Indicators: map[string][]Indicator{
+			"BTCUSDT": {
+				{
+					IsLocked:           true,
+					PlannedRecoverTime: 1545741270000,
+					Indicator:          "UFR",
+					Value:              0.05,
+					TriggerValue:       0.995,
+				},
+			},
+			"ACCOUNT": {
+				{
+					Indicator:    "TMV",
+					Value:        10,
+					TriggerValue: 1,
+				},
+			},
+		},
+		UpdateTime: 1545741270000,
+	}
+	r.Equal(e, res)
+	r.False(res.IsLocked)
+	r.True(res.Locked())
+	r.Equal(map[string][]Indicator{
+		"BTCUSDT": e.Indicators["BTCUSDT"],
+		"ACCOUNT": e.Indicators["ACCOUNT"],
+	}, res.NearLock(0.8))
+}
+
+func (s *apiTradingStatusServiceTestSuite) TestNearLock() {
+	status := &FuturesApiTradingStatus{
+		Indicators: map[string][]Indicator{
+			"BTCUSDT": {
+				{Indicator: "UFR", Value: 0.9, TriggerValue: 0.995},
+				{Indicator: "IFER", Value: 0.1, TriggerValue: 0.99},
+			},
+			"ETHUSDT": {
+				{Indicator: "GCR", Value: 0.2, TriggerValue: 0.99},
+			},
+		},
+	}
+	s.r().Equal(map[string][]Indicator{
+		"BTCUSDT": {{Indicator: "UFR", Value: 0.9, TriggerValue: 0.995}},
+	}, status.NearLock(0.8))
+	s.r().Empty(status.NearLock(1))
+}
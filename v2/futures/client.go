@@ -8,6 +8,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -20,16 +21,16 @@ import (
 	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 )
 
-// SideType define side type of order
+// SideType define side type of order, see ParseSideType to convert user input
 type SideType string
 
-// PositionSideType define position side type of order
+// PositionSideType define position side type of order, see ParsePositionSideType to convert user input
 type PositionSideType string
 
-// OrderType define order type
+// OrderType define order type, see ParseOrderType to convert user input
 type OrderType string
 
-// TimeInForceType define time in force type of order
+// TimeInForceType define time in force type of order, see ParseTimeInForce to convert user input
 type TimeInForceType string
 
 // NewOrderRespType define response JSON verbosity
@@ -56,12 +57,24 @@ type SideEffectType string
 // WorkingType define working type
 type WorkingType string
 
+// PriceMatchType define how the price of an order follows the order book
+type PriceMatchType string
+
+// STPMode define the self trade prevention mode of an order
+type STPMode string
+
 // MarginType define margin type
 type MarginType string
 
 // ContractType define contract type
 type ContractType string
 
+// IncomeType define income type of the income history
+type IncomeType string
+
+// PeriodType define the period of the futures statistics
+type PeriodType string
+
 // UserDataEventType define user data event type
 type UserDataEventType string
 
@@ -77,6 +90,9 @@ const (
 	baseApiTestnetUrl = "https://testnet.binancefuture.com"
 )
 
+// BaseAPITestnetURL is the base endpoint of the testnet Rest API
+const BaseAPITestnetURL = baseApiTestnetUrl
+
 // Global enums
 const (
 	SideTypeBuy  SideType = "BUY"
@@ -98,6 +114,7 @@ const (
 	TimeInForceTypeIOC TimeInForceType = "IOC" // Immediate or Cancel
 	TimeInForceTypeFOK TimeInForceType = "FOK" // Fill or Kill
 	TimeInForceTypeGTX TimeInForceType = "GTX" // Good Till Crossing (Post Only)
+	TimeInForceTypeGTD TimeInForceType = "GTD" // Good Till Date
 
 	NewOrderRespTypeACK    NewOrderRespType = "ACK"
 	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
@@ -124,6 +141,15 @@ const (
 	WorkingTypeMarkPrice     WorkingType = "MARK_PRICE"
 	WorkingTypeContractPrice WorkingType = "CONTRACT_PRICE"
 
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+
 	SymbolStatusTypePreTrading   SymbolStatusType = "PRE_TRADING"
 	SymbolStatusTypeTrading      SymbolStatusType = "TRADING"
 	SymbolStatusTypePostTrading  SymbolStatusType = "POST_TRADING"
@@ -144,10 +170,53 @@ const (
 	SideEffectTypeMarginBuy    SideEffectType = "MARGIN_BUY"
 	SideEffectTypeAutoRepay    SideEffectType = "AUTO_REPAY"
 
+	STPModeExpireTaker STPMode = "EXPIRE_TAKER"
+	STPModeExpireMaker STPMode = "EXPIRE_MAKER"
+	STPModeExpireBoth  STPMode = "EXPIRE_BOTH"
+	STPModeNone        STPMode = "NONE"
+
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
-
-	ContractTypePerpetual ContractType = "PERPETUAL"
+	// the positions of the ACCOUNT_UPDATE user data events report the margin type in lower case
+	MarginTypeUserDataIsolated MarginType = "isolated"
+	MarginTypeUserDataCross    MarginType = "cross"
+
+	ContractTypePerpetual           ContractType = "PERPETUAL"
+	ContractTypeCurrentMonth        ContractType = "CURRENT_MONTH"
+	ContractTypeNextMonth           ContractType = "NEXT_MONTH"
+	ContractTypeCurrentQuarter      ContractType = "CURRENT_QUARTER"
+	ContractTypeNextQuarter         ContractType = "NEXT_QUARTER"
+	ContractTypePerpetualDelivering ContractType = "PERPETUAL_DELIVERING"
+
+	IncomeTypeTransfer                 IncomeType = "TRANSFER"
+	IncomeTypeWelcomeBonus             IncomeType = "WELCOME_BONUS"
+	IncomeTypeRealizedPnl              IncomeType = "REALIZED_PNL"
+	IncomeTypeFundingFee               IncomeType = "FUNDING_FEE"
+	IncomeTypeCommission               IncomeType = "COMMISSION"
+	IncomeTypeInsuranceClear           IncomeType = "INSURANCE_CLEAR"
+	IncomeTypeReferralKickback         IncomeType = "REFERRAL_KICKBACK"
+	IncomeTypeCommissionRebate         IncomeType = "COMMISSION_REBATE"
+	IncomeTypeAPIRebate                IncomeType = "API_REBATE"
+	IncomeTypeContestReward            IncomeType = "CONTEST_REWARD"
+	IncomeTypeCrossCollateralTransfer  IncomeType = "CROSS_COLLATERAL_TRANSFER"
+	IncomeTypeOptionsPremiumFee        IncomeType = "OPTIONS_PREMIUM_FEE"
+	IncomeTypeOptionsSettleProfit      IncomeType = "OPTIONS_SETTLE_PROFIT"
+	IncomeTypeInternalTransfer         IncomeType = "INTERNAL_TRANSFER"
+	IncomeTypeAutoExchange             IncomeType = "AUTO_EXCHANGE"
+	IncomeTypeDeliveredSettlement      IncomeType = "DELIVERED_SETTELMENT"
+	IncomeTypeCoinSwapDeposit          IncomeType = "COIN_SWAP_DEPOSIT"
+	IncomeTypeCoinSwapWithdraw         IncomeType = "COIN_SWAP_WITHDRAW"
+	IncomeTypePositionLimitIncreaseFee IncomeType = "POSITION_LIMIT_INCREASE_FEE"
+
+	Period5m  PeriodType = "5m"
+	Period15m PeriodType = "15m"
+	Period30m PeriodType = "30m"
+	Period1h  PeriodType = "1h"
+	Period2h  PeriodType = "2h"
+	Period4h  PeriodType = "4h"
+	Period6h  PeriodType = "6h"
+	Period12h PeriodType = "12h"
+	Period1d  PeriodType = "1d"
 
 	UserDataEventTypeListenKeyExpired    UserDataEventType = "listenKeyExpired"
 	UserDataEventTypeMarginCall          UserDataEventType = "MARGIN_CALL"
@@ -169,6 +238,9 @@ const (
 	UserDataEventReasonTypeAssetTransfer       UserDataEventReasonType = "ASSET_TRANSFER"
 	UserDataEventReasonTypeOptionsPremiumFee   UserDataEventReasonType = "OPTIONS_PREMIUM_FEE"
 	UserDataEventReasonTypeOptionsSettleProfit UserDataEventReasonType = "OPTIONS_SETTLE_PROFIT"
+	UserDataEventReasonTypeAutoExchange        UserDataEventReasonType = "AUTO_EXCHANGE"
+	UserDataEventReasonTypeCoinSwapDeposit     UserDataEventReasonType = "COIN_SWAP_DEPOSIT"
+	UserDataEventReasonTypeCoinSwapWithdraw    UserDataEventReasonType = "COIN_SWAP_WITHDRAW"
 
 	ForceOrderCloseTypeLiquidation ForceOrderCloseType = "LIQUIDATION"
 	ForceOrderCloseTypeADL         ForceOrderCloseType = "ADL"
@@ -201,8 +273,8 @@ func getApiEndpoint() string {
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
-func NewClient(apiKey, secretKey string) *Client {
-	return &Client{
+func NewClient(apiKey, secretKey string, opts ...ClientOption) *Client {
+	c := &Client{
 		APIKey:     apiKey,
 		SecretKey:  secretKey,
 		BaseURL:    getApiEndpoint(),
@@ -210,10 +282,11 @@ func NewClient(apiKey, secretKey string) *Client {
 		HTTPClient: http.DefaultClient,
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
+	return c.applyOptions(opts)
 }
 
 // NewProxiedClient passing a proxy url
-func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
+func NewProxiedClient(apiKey, secretKey, proxyUrl string, opts ...ClientOption) *Client {
 	proxy, err := url.Parse(proxyUrl)
 	if err != nil {
 		log.Fatal(err)
@@ -222,7 +295,7 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		Proxy:           http.ProxyURL(proxy),
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 	}
-	return &Client{
+	c := &Client{
 		APIKey:    apiKey,
 		SecretKey: secretKey,
 		BaseURL:   getApiEndpoint(),
@@ -232,6 +305,7 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		},
 		Logger: log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
+	return c.applyOptions(opts)
 }
 
 type doFunc func(req *http.Request) (*http.Response, error)
@@ -245,16 +319,72 @@ type Client struct {
 	HTTPClient *http.Client
 	Debug      bool
 	Logger     *log.Logger
-	TimeOffset int64
-	do         doFunc
+	// StructuredLogger receives the request logs with secrets redacted,
+	// Logger is used in debug mode when it is not set
+	StructuredLogger common.Logger
+	// RequestHook is called around every request, for tracing and metrics
+	RequestHook common.RequestHook
+	TimeOffset  int64
+	do          doFunc
+
+	debugWriter    io.Writer
+	debugBodyLimit int
+	debugOut       *common.DebugWriter
+
+	dryRun      bool
+	dryRunInfo  *ExchangeInfo
+	usedWeight  weightTracker
+	middlewares []Middleware
+}
+
+// ClientOption define option type for client
+type ClientOption func(*Client)
+
+// WithDebug write each raw request url and body and each response body to w, prefixed with a timestamp.
+// Signatures and listen keys are redacted and bodies are truncated, see WithDebugBodyLimit.
+func WithDebug(w io.Writer) ClientOption {
+	return func(c *Client) {
+		c.debugWriter = w
+	}
 }
 
-func (c *Client) debug(format string, v ...interface{}) {
-	if c.Debug {
-		c.Logger.Printf(format, v...)
+// WithDebugBodyLimit set the number of body bytes written by WithDebug, common.DefaultDebugBodyLimit by default
+func WithDebugBodyLimit(limit int) ClientOption {
+	return func(c *Client) {
+		c.debugBodyLimit = limit
 	}
 }
 
+func (c *Client) applyOptions(opts []ClientOption) *Client {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debugWriter != nil {
+		c.debugOut = common.NewDebugWriter(c.debugWriter, c.debugBodyLimit)
+	}
+	return c
+}
+
+// logger return the structured logger of the client
+func (c *Client) logger() common.Logger {
+	if c.StructuredLogger != nil {
+		return c.StructuredLogger
+	}
+	if c.Debug && c.Logger != nil {
+		return common.NewStdLogger(c.Logger)
+	}
+	return common.NopLogger
+}
+
+func (c *Client) debug(msg string, keyvals ...interface{}) {
+	c.logger().Debug(msg, keyvals...)
+}
+
+// debugEnabled report whether debug lines are logged, callers skip building costly values otherwise
+func (c *Client) debugEnabled() bool {
+	return c.logger() != common.NopLogger
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -305,7 +435,9 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
+	if c.debugEnabled() {
+		c.debug("parsed request", "url", common.Redact(fullURL), "body", common.Redact(bodyString))
+	}
 
 	r.fullURL = fullURL
 	r.header = header
@@ -314,6 +446,31 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	data, h, err := c.chain(func(ctx context.Context, _ Request) ([]byte, http.Header, error) {
+		data, header, err := c.doAPI(ctx, r, opts...)
+		return data, *header, err
+	})(ctx, r)
+	if h == nil {
+		h = http.Header{}
+	}
+	return data, &h, err
+}
+
+// doAPI send a request and return the response body and header
+func (c *Client) doAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	var res *http.Response
+	if c.RequestHook != nil {
+		info := &common.RequestInfo{Method: r.method, Endpoint: r.endpoint, Start: time.Now()}
+		ctx = c.RequestHook.OnRequestStart(ctx, info)
+		defer func() {
+			out := &common.ResponseInfo{Duration: time.Since(info.Start), Err: err}
+			if res != nil {
+				out.StatusCode = res.StatusCode
+				out.UsedWeight = common.UsedWeight(res.Header)
+			}
+			c.RequestHook.OnRequestEnd(ctx, info, out)
+		}()
+	}
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
@@ -324,19 +481,30 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v", req)
+	if c.debugOut != nil {
+		c.debugOut.Request(r.method, r.fullURL, r.form.Encode())
+	}
+	if c.debugEnabled() {
+		c.debug("request", "method", req.Method, "url", common.Redact(r.fullURL), "header", common.RedactHeader(req.Header))
+	}
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
-	res, err := f(req)
+	c.usedWeight.add(time.Now(), c.EstimateWeight(r.method, r.endpoint, r.query))
+	res, err = f(req)
 	if err != nil {
+		c.logger().Error("request failed", "method", req.Method, "url", common.Redact(r.fullURL), "error", err)
 		return []byte{}, &http.Header{}, err
 	}
+	c.usedWeight.update(time.Now(), res.Header)
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
 	}
+	if c.debugOut != nil {
+		c.debugOut.Response(res.StatusCode, r.fullURL, data)
+	}
 	defer func() {
 		cerr := res.Body.Close()
 		// Only overwrite the retured error if the original error was nil and an
@@ -345,16 +513,17 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
+	if c.debugEnabled() {
+		c.debug("response", "url", common.Redact(r.fullURL), "status", res.StatusCode, "header", res.Header, "body", common.Redact(string(data)))
+	}
 
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
 		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
+			c.debug("failed to unmarshal json", "error", e)
 		}
+		c.logger().Error("api error", "method", req.Method, "url", common.Redact(r.fullURL), "status", res.StatusCode, "code", apiErr.Code, "msg", apiErr.Message)
 		return nil, &http.Header{}, apiErr
 	}
 	return data, &res.Header, nil
@@ -461,6 +630,11 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewCancelAllFuturesOpenOrdersService init cancel all open orders service returning the confirmation
+func (c *Client) NewCancelAllFuturesOpenOrdersService() *CancelAllFuturesOpenOrdersService {
+	return &CancelAllFuturesOpenOrdersService{c: c}
+}
+
 // NewCancelMultipleOrdersService init cancel multiple orders service
 func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
 	return &CancelMultiplesOrdersService{c: c}
@@ -496,6 +670,11 @@ func (c *Client) NewGetPositionRiskService() *GetPositionRiskService {
 	return &GetPositionRiskService{c: c}
 }
 
+// NewGetPositionRiskV3Service init getting v3 position risk service
+func (c *Client) NewGetPositionRiskV3Service() *GetPositionRiskV3Service {
+	return &GetPositionRiskV3Service{c: c}
+}
+
 // NewGetPositionMarginHistoryService init getting position margin history service
 func (c *Client) NewGetPositionMarginHistoryService() *GetPositionMarginHistoryService {
 	return &GetPositionMarginHistoryService{c: c}
@@ -571,6 +750,11 @@ func (c *Client) NewChangeMarginTypeService() *ChangeMarginTypeService {
 	return &ChangeMarginTypeService{c: c}
 }
 
+// NewGetSymbolConfigService init symbol config service
+func (c *Client) NewGetSymbolConfigService() *GetSymbolConfigService {
+	return &GetSymbolConfigService{c: c}
+}
+
 // NewUpdatePositionMarginService init update position margin
 func (c *Client) NewUpdatePositionMarginService() *UpdatePositionMarginService {
 	return &UpdatePositionMarginService{c: c}
@@ -620,3 +804,88 @@ func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsServi
 func (c *Client) NewLongShortRatioService() *LongShortRatioService {
 	return &LongShortRatioService{c: c}
 }
+
+// NewGetAllFuturesConvertPairsService init get all futures convert pairs service
+func (c *Client) NewGetAllFuturesConvertPairsService() *GetAllFuturesConvertPairsService {
+	return &GetAllFuturesConvertPairsService{c: c}
+}
+
+// NewGetFuturesApiTradingStatusService init get futures API trading status service
+func (c *Client) NewGetFuturesApiTradingStatusService() *GetFuturesApiTradingStatusService {
+	return &GetFuturesApiTradingStatusService{c: c}
+}
+
+// NewGetInsuranceBalanceService init get insurance balance service
+func (c *Client) NewGetInsuranceBalanceService() *GetInsuranceBalanceService {
+	return &GetInsuranceBalanceService{c: c}
+}
+
+// NewGetDeliveryPriceService init get delivery price service
+func (c *Client) NewGetDeliveryPriceService() *GetDeliveryPriceService {
+	return &GetDeliveryPriceService{c: c}
+}
+
+// NewGetIndexInfoService init get index info service
+func (c *Client) NewGetIndexInfoService() *GetIndexInfoService {
+	return &GetIndexInfoService{c: c}
+}
+
+// NewGetConstituentsService init get index constituents service
+func (c *Client) NewGetConstituentsService() *GetConstituentsService {
+	return &GetConstituentsService{c: c}
+}
+
+// NewGetPMProAccountService init get portfolio margin pro account service
+func (c *Client) NewGetPMProAccountService() *GetPMProAccountService {
+	return &GetPMProAccountService{c: c}
+}
+
+// NewRequestIncomeDownloadService init request income history download service
+func (c *Client) NewRequestIncomeDownloadService() *RequestIncomeDownloadService {
+	return &RequestIncomeDownloadService{c: c}
+}
+
+// NewGetIncomeDownloadLinkService init get income history download link service
+func (c *Client) NewGetIncomeDownloadLinkService() *GetIncomeDownloadLinkService {
+	return &GetIncomeDownloadLinkService{c: c}
+}
+
+// NewRequestOrderDownloadService init request order history download service
+func (c *Client) NewRequestOrderDownloadService() *RequestOrderDownloadService {
+	return &RequestOrderDownloadService{c: c}
+}
+
+// NewGetOrderDownloadLinkService init get order history download link service
+func (c *Client) NewGetOrderDownloadLinkService() *GetOrderDownloadLinkService {
+	return &GetOrderDownloadLinkService{c: c}
+}
+
+// NewRequestTradeDownloadService init request trade history download service
+func (c *Client) NewRequestTradeDownloadService() *RequestTradeDownloadService {
+	return &RequestTradeDownloadService{c: c}
+}
+
+// NewGetTradeDownloadLinkService init get trade history download link service
+func (c *Client) NewGetTradeDownloadLinkService() *GetTradeDownloadLinkService {
+	return &GetTradeDownloadLinkService{c: c}
+}
+
+// NewChangeFeeBurnService init change BNB fee discount service
+func (c *Client) NewChangeFeeBurnService() *ChangeFeeBurnService {
+	return &ChangeFeeBurnService{c: c}
+}
+
+// NewGetFeeBurnService init get BNB fee discount service
+func (c *Client) NewGetFeeBurnService() *GetFeeBurnService {
+	return &GetFeeBurnService{c: c}
+}
+
+// NewGetMaxWithdrawableMarginService init get max withdrawable margin service
+func (c *Client) NewGetMaxWithdrawableMarginService() *GetMaxWithdrawableMarginService {
+	return &GetMaxWithdrawableMarginService{c: c}
+}
+
+// NewPremiumIndexKlinesService init premiumIndexKlines service
+func (c *Client) NewPremiumIndexKlinesService() *PremiumIndexKlinesService {
+	return &PremiumIndexKlinesService{c: c}
+}
@@ -123,6 +123,7 @@ func (m *mockedClient) do(req *http.Request) (*http.Response, error) {
 	if m.assertReq != nil {
 		r := newRequest()
 		r.query = req.URL.Query()
+		r.header = req.Header
 		if req.Body != nil {
 			bs := make([]byte, req.ContentLength)
 			for {
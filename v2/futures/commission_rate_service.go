@@ -3,15 +3,20 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 )
 
+// CommissionRateService get the maker and taker commission rates of the account for a symbol
 type CommissionRateService struct {
 	c      *Client
 	symbol string
 }
 
-// Symbol set symbol
+// GetFuturesCommissionRateService get the commission rates of a symbol, see CommissionRateService
+type GetFuturesCommissionRateService = CommissionRateService
+
+// Symbol set symbol, it is required
 func (service *CommissionRateService) Symbol(symbol string) *CommissionRateService {
 	service.symbol = symbol
 	return service
@@ -24,9 +29,10 @@ func (s *CommissionRateService) Do(ctx context.Context, opts ...RequestOption) (
 		endpoint: "/fapi/v1/commissionRate",
 		secType:  secTypeSigned,
 	}
-	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+	if s.symbol == "" {
+		return nil, fmt.Errorf("%w: symbol is required", ErrInvalidParam)
 	}
+	r.setParam("symbol", s.symbol)
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -39,7 +45,7 @@ func (s *CommissionRateService) Do(ctx context.Context, opts ...RequestOption) (
 	return res, nil
 }
 
-// Commission Rate
+// CommissionRate define the commission rates of a symbol
 type CommissionRate struct {
 	Symbol              string `json:"symbol"`
 	MakerCommissionRate string `json:"makerCommissionRate"`
@@ -50,3 +50,8 @@ func (commissionRateServiceTestSuite *commissionRateServiceTestSuite) assertComm
 	assertion.Equal(expectation.MakerCommissionRate, assertedData.MakerCommissionRate, "MakerCommissionRate")
 	assertion.Equal(expectation.TakerCommissionRate, assertedData.TakerCommissionRate, "TakerCommissionRate")
 }
+
+func (s *commissionRateServiceTestSuite) TestCommissionRateWithoutSymbol() {
+	_, err := s.client.NewCommissionRateService().Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
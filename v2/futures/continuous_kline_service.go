@@ -6,26 +6,28 @@ import (
 	"net/http"
 )
 
-// ContinuousKlinesService list klines
+// ContinuousKlinesService list the klines of the continuous contract of a pair, which follows the
+// contract of contractType across rollovers: PERPETUAL, CURRENT_MONTH, NEXT_MONTH,
+// CURRENT_QUARTER or NEXT_QUARTER
 type ContinuousKlinesService struct {
 	c            *Client
 	pair         string
-	contractType string
+	contractType ContractType
 	interval     string
 	limit        *int
 	startTime    *int64
 	endTime      *int64
 }
 
-// pair set pair
+// Pair set pair
 func (s *ContinuousKlinesService) Pair(pair string) *ContinuousKlinesService {
 	s.pair = pair
 	return s
 }
 
-// contractType set contractType
+// ContractType set contractType, one of the ContractType values
 func (s *ContinuousKlinesService) ContractType(contractType string) *ContinuousKlinesService {
-	s.contractType = contractType
+	s.contractType = ContractType(contractType)
 	return s
 }
 
@@ -55,6 +57,9 @@ func (s *ContinuousKlinesService) EndTime(endTime int64) *ContinuousKlinesServic
 
 // Do send request
 func (s *ContinuousKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*ContinuousKline, err error) {
+	if err = validEnum("contractType", s.contractType); err != nil {
+		return []*ContinuousKline{}, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/continuousKlines",
@@ -118,3 +123,6 @@ type ContinuousKline struct {
 	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
 	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
 }
+
+// GetContinuousContractKlineService list continuous contract klines, see ContinuousKlinesService
+type GetContinuousContractKlineService = ContinuousKlinesService
@@ -100,6 +100,12 @@ func (s *ContinuousklineServiceTestSuite) TestContinuousKlines() {
 	s.assertContinuousKlineEqual(kline2, klines[1])
 }
 
+func (s *ContinuousklineServiceTestSuite) TestContinuousKlinesInvalidContractType() {
+	_, err := s.client.NewContinuousKlinesService().Pair("BTCUSDT").
+		ContractType("QUARTER").Interval("1h").Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
+
 func (s *ContinuousklineServiceTestSuite) assertContinuousKlineEqual(e, a *ContinuousKline) {
 	r := s.r()
 	r.Equal(e.OpenTime, a.OpenTime, "OpenTime")
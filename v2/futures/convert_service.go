@@ -0,0 +1,43 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetAllFuturesConvertPairsService list all convert pairs available on futures.
+// Binance returns every pair when neither fromAsset nor toAsset is sent.
+type GetAllFuturesConvertPairsService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAllFuturesConvertPairsService) Do(ctx context.Context, opts ...RequestOption) (res []*ConvertExchangeInfo, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/convert/exchangeInfo",
+		secType:  secTypeNone,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ConvertExchangeInfo{}, err
+	}
+	// the unfiltered list is large, preallocate to avoid repeated growth
+	res = make([]*ConvertExchangeInfo, 0, 512)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ConvertExchangeInfo{}, err
+	}
+	return res, nil
+}
+
+// ConvertExchangeInfo define a convert pair and its amount limits
+type ConvertExchangeInfo struct {
+	FromAsset          string `json:"fromAsset"`
+	ToAsset            string `json:"toAsset"`
+	FromAssetMinAmount string `json:"fromAssetMinAmount"`
+	FromAssetMaxAmount string `json:"fromAssetMaxAmount"`
+	ToAssetMinAmount   string `json:"toAssetMinAmount"`
+	ToAssetMaxAmount   string `json:"toAssetMaxAmount"`
+}
@@ -0,0 +1,78 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type convertServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestConvertService(t *testing.T) {
+	suite.Run(t, new(convertServiceTestSuite))
+}
+
+func (s *convertServiceTestSuite) TestGetAllFuturesConvertPairs() {
+	data := []byte(`[
+		{
+			"fromAsset": "BTC",
+			"toAsset": "USDT",
+			"fromAssetMinAmount": "0.0004",
+			"fromAssetMaxAmount": "50",
+			"toAssetMinAmount": "20",
+			"toAssetMaxAmount": "2500000"
+		},
+		{
+			"fromAsset": "USDT",
+			"toAsset": "BNB",
+			"fromAssetMinAmount": "10",
+			"fromAssetMaxAmount": "1000000",
+			"toAssetMinAmount": "0.02",
+			"toAssetMaxAmount": "2000"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAllFuturesConvertPairsService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 2)
+	e := []*ConvertExchangeInfo{
+		{
+			FromAsset:          "BTC",
+			ToAsset:            "USDT",
+			FromAssetMinAmount: "0.0004",
+			FromAssetMaxAmount: "50",
+			ToAssetMinAmount:   "20",
+			ToAssetMaxAmount:   "2500000",
+		},
+		{
+			FromAsset:          "USDT",
+			ToAsset:            "BNB",
+			FromAssetMinAmount: "10",
+			FromAssetMaxAmount: "1000000",
+			ToAssetMinAmount:   "0.02",
+			ToAssetMaxAmount:   "2000",
+		},
+	}
+	for i := range e {
+		s.assertConvertExchangeInfoEqual(e[i], res[i])
+	}
+}
+
+func (s *convertServiceTestSuite) assertConvertExchangeInfoEqual(e, a *ConvertExchangeInfo) {
+	r := s.r()
+	r.Equal(e.FromAsset, a.FromAsset, "FromAsset")
+	r.Equal(e.ToAsset, a.ToAsset, "ToAsset")
+	r.Equal(e.FromAssetMinAmount, a.FromAssetMinAmount, "FromAssetMinAmount")
+	r.Equal(e.FromAssetMaxAmount, a.FromAssetMaxAmount, "FromAssetMaxAmount")
+	r.Equal(e.ToAssetMinAmount, a.ToAssetMinAmount, "ToAssetMinAmount")
+	r.Equal(e.ToAssetMaxAmount, a.ToAssetMaxAmount, "ToAssetMaxAmount")
+}
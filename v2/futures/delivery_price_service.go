@@ -0,0 +1,52 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetDeliveryPriceService list the settlement prices of the quarterly contracts of a pair
+type GetDeliveryPriceService struct {
+	c    *Client
+	pair string
+}
+
+// Pair set pair
+func (s *GetDeliveryPriceService) Pair(pair string) *GetDeliveryPriceService {
+	s.pair = pair
+	return s
+}
+
+// Do send request
+func (s *GetDeliveryPriceService) Do(ctx context.Context, opts ...RequestOption) (res []*DeliveryPrice, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/delivery-price",
+	}
+	r.setParam("pair", s.pair)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*DeliveryPrice{}, err
+	}
+	// the delivery price is sent as a number
+	raw := make([]struct {
+		DeliveryTime  int64       `json:"deliveryTime"`
+		DeliveryPrice json.Number `json:"deliveryPrice"`
+	}, 0)
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		return []*DeliveryPrice{}, err
+	}
+	res = make([]*DeliveryPrice, 0, len(raw))
+	for _, p := range raw {
+		res = append(res, &DeliveryPrice{DeliveryTime: p.DeliveryTime, DeliveryPrice: p.DeliveryPrice.String()})
+	}
+	return res, nil
+}
+
+// DeliveryPrice define the settlement price of a quarterly contract
+type DeliveryPrice struct {
+	DeliveryTime  int64  `json:"deliveryTime"`
+	DeliveryPrice string `json:"deliveryPrice"`
+}
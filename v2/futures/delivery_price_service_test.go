@@ -0,0 +1,43 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type deliveryPriceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDeliveryPriceService(t *testing.T) {
+	suite.Run(t, new(deliveryPriceServiceTestSuite))
+}
+
+func (s *deliveryPriceServiceTestSuite) TestGetDeliveryPrice() {
+	data := []byte(`[
+		{
+			"deliveryTime": 1695945600000,
+			"deliveryPrice": 27103.00000000
+		},
+		{
+			"deliveryTime": 1688083200000,
+			"deliveryPrice": 30733.60000000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("pair", pair)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetDeliveryPriceService().Pair(pair).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*DeliveryPrice{
+		{DeliveryTime: 1695945600000, DeliveryPrice: "27103.00000000"},
+		{DeliveryTime: 1688083200000, DeliveryPrice: "30733.60000000"},
+	}, res)
+}
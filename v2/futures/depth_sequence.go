@@ -0,0 +1,71 @@
+package futures
+
+import "sync"
+
+// WsDepthGap define a gap in a diff depth stream: the pu of an event does not match the u
+// of the previous event of its symbol, so at least one event was missed
+type WsDepthGap struct {
+	Symbol string
+	// ExpectedPrevLastUpdateID is the u of the previous event
+	ExpectedPrevLastUpdateID int64
+	// PrevLastUpdateID is the pu of the event following the gap
+	PrevLastUpdateID int64
+	// LastUpdateID is the u of the event following the gap
+	LastUpdateID int64
+}
+
+// WsDepthGapHandler handle a gap in a diff depth stream
+type WsDepthGapHandler func(gap *WsDepthGap)
+
+// WsDepthSequenceValidator check the sequence of the diff depth events of one or more symbols.
+// The first event of a symbol is always in sequence, call Reset when reconnecting.
+type WsDepthSequenceValidator struct {
+	mu   sync.Mutex
+	last map[string]int64
+}
+
+// NewWsDepthSequenceValidator init a WsDepthSequenceValidator
+func NewWsDepthSequenceValidator() *WsDepthSequenceValidator {
+	return &WsDepthSequenceValidator{last: make(map[string]int64)}
+}
+
+// Check record event and return the gap before it, nil if it is in sequence
+func (v *WsDepthSequenceValidator) Check(event *WsDepthEvent) *WsDepthGap {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	last, ok := v.last[event.Symbol]
+	v.last[event.Symbol] = event.LastUpdateID
+	if !ok || event.PrevLastUpdateID == last {
+		return nil
+	}
+	return &WsDepthGap{
+		Symbol:                   event.Symbol,
+		ExpectedPrevLastUpdateID: last,
+		PrevLastUpdateID:         event.PrevLastUpdateID,
+		LastUpdateID:             event.LastUpdateID,
+	}
+}
+
+// Reset forget the previous events of every symbol
+func (v *WsDepthSequenceValidator) Reset() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.last = make(map[string]int64)
+}
+
+// Handler wrap handler to call gapHandler before the events following a gap,
+// every event is still delivered to handler
+func (v *WsDepthSequenceValidator) Handler(handler WsDepthHandler, gapHandler WsDepthGapHandler) WsDepthHandler {
+	return func(event *WsDepthEvent) {
+		if gap := v.Check(event); gap != nil {
+			gapHandler(gap)
+		}
+		handler(event)
+	}
+}
+
+// WsDiffDepthServeValidated is similar to WsDiffDepthServe, but gapHandler is called when an event
+// was missed, callers decide whether to resync their book. The sequence starts over with the connection.
+func WsDiffDepthServeValidated(symbol string, handler WsDepthHandler, gapHandler WsDepthGapHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsDiffDepthServe(symbol, NewWsDepthSequenceValidator().Handler(handler, gapHandler), errHandler, opts...)
+}
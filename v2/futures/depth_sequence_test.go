@@ -0,0 +1,81 @@
+package futures
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWsDepthSequenceValidator(t *testing.T) {
+	r := require.New(t)
+	v := NewWsDepthSequenceValidator()
+	event := func(symbol string, pu, u int64) *WsDepthEvent {
+		return &WsDepthEvent{Symbol: symbol, PrevLastUpdateID: pu, LastUpdateID: u}
+	}
+
+	r.Nil(v.Check(event("BTCUSDT", 90, 100)))
+	r.Nil(v.Check(event("BTCUSDT", 100, 110)))
+	r.Nil(v.Check(event("ETHUSDT", 5, 10)))
+	r.Equal(&WsDepthGap{
+		Symbol:                   "BTCUSDT",
+		ExpectedPrevLastUpdateID: 110,
+		PrevLastUpdateID:         120,
+		LastUpdateID:             130,
+	}, v.Check(event("BTCUSDT", 120, 130)))
+	// the sequence goes on from the event following the gap
+	r.Nil(v.Check(event("BTCUSDT", 130, 140)))
+	r.Nil(v.Check(event("ETHUSDT", 10, 20)))
+
+	v.Reset()
+	r.Nil(v.Check(event("BTCUSDT", 500, 510)))
+}
+
+func TestWsDiffDepthServeValidated(t *testing.T) {
+	r := require.New(t)
+	origWsServe := wsServe
+	defer func() {
+		wsServe = origWsServe
+	}()
+	var messages [][]byte
+	for _, ids := range [][2]int64{{90, 100}, {100, 110}, {120, 130}, {130, 140}} {
+		messages = append(messages, []byte(fmt.Sprintf(
+			`{"e":"depthUpdate","E":1,"T":1,"s":"BTCUSDT","U":1,"u":%d,"pu":%d,"b":[],"a":[]}`, ids[1], ids[0])))
+	}
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		for _, message := range messages {
+			handler(message)
+		}
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+
+	var updateIDs []int64
+	var gaps []*WsDepthGap
+	serve := func() {
+		doneC, stopC, err := WsDiffDepthServeValidated("BTCUSDT", func(event *WsDepthEvent) {
+			updateIDs = append(updateIDs, event.LastUpdateID)
+		}, func(gap *WsDepthGap) {
+			gaps = append(gaps, gap)
+		}, func(err error) {
+			r.NoError(err)
+		})
+		r.NoError(err)
+		stopC <- struct{}{}
+		<-doneC
+	}
+	serve()
+	r.Equal([]int64{100, 110, 130, 140}, updateIDs)
+	r.Equal([]*WsDepthGap{{Symbol: "BTCUSDT", ExpectedPrevLastUpdateID: 110, PrevLastUpdateID: 120, LastUpdateID: 130}}, gaps)
+
+	// a new connection starts a new sequence, its first event is not compared to the
+	// last event of the previous connection
+	serve()
+	r.Len(gaps, 2)
+	r.Equal(gaps[0], gaps[1])
+}
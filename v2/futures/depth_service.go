@@ -2,7 +2,10 @@ package futures
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 )
@@ -20,12 +23,21 @@ func (s *DepthService) Symbol(symbol string) *DepthService {
 	return s
 }
 
-// Limit set limit
+// Limit set limit: 5, 10, 20, 50, 100, 500 (default) or 1000
 func (s *DepthService) Limit(limit int) *DepthService {
 	s.limit = &limit
 	return s
 }
 
+// Weight return the request weight of the request, which scales with the limit
+func (s *DepthService) Weight() int {
+	params := url.Values{}
+	if s.limit != nil {
+		params.Set("limit", strconv.Itoa(*s.limit))
+	}
+	return s.c.EstimateWeight(http.MethodGet, "/fapi/v1/depth", params)
+}
+
 // Do send request
 func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *DepthResponse, err error) {
 	r := &request{
@@ -40,40 +52,29 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	if err != nil {
 		return nil, err
 	}
-	j, err := newJSON(data)
+	res = new(DepthResponse)
+	err = json.Unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
-	res = new(DepthResponse)
-	res.Time = j.Get("E").MustInt64()
-	res.TradeTime = j.Get("T").MustInt64()
-	res.LastUpdateID = j.Get("lastUpdateId").MustInt64()
-	bidsLen := len(j.Get("bids").MustArray())
-	res.Bids = make([]Bid, bidsLen)
-	for i := 0; i < bidsLen; i++ {
-		item := j.Get("bids").GetIndex(i)
-		res.Bids[i] = Bid{
-			Price:    item.GetIndex(0).MustString(),
-			Quantity: item.GetIndex(1).MustString(),
-		}
+	if res.Bids == nil {
+		res.Bids = []Bid{}
 	}
-	asksLen := len(j.Get("asks").MustArray())
-	res.Asks = make([]Ask, asksLen)
-	for i := 0; i < asksLen; i++ {
-		item := j.Get("asks").GetIndex(i)
-		res.Asks[i] = Ask{
-			Price:    item.GetIndex(0).MustString(),
-			Quantity: item.GetIndex(1).MustString(),
-		}
+	if res.Asks == nil {
+		res.Asks = []Ask{}
 	}
 	return res, nil
 }
 
+// GetDepthService show the order book snapshot to start from before applying the diff depth
+// websocket events, see DepthService
+type GetDepthService = DepthService
+
 // DepthResponse define depth info with bids and asks
 type DepthResponse struct {
 	LastUpdateID int64 `json:"lastUpdateId"`
-	Time         int64 `json:"E"`
-	TradeTime    int64 `json:"T"`
+	Time         int64 `json:"E"` // message output time
+	TradeTime    int64 `json:"T"` // transaction time
 	Bids         []Bid `json:"bids"`
 	Asks         []Ask `json:"asks"`
 }
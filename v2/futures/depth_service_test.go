@@ -17,6 +17,8 @@ func TestDepthService(t *testing.T) {
 func (s *depthServiceTestSuite) TestDepth() {
 	data := []byte(`{
         "lastUpdateId": 1027024,
+        "E": 1589436922972,
+        "T": 1589436922959,
         "bids": [
             [
                 "4.00000000",
@@ -43,6 +45,8 @@ func (s *depthServiceTestSuite) TestDepth() {
 	s.r().NoError(err)
 	e := &DepthResponse{
 		LastUpdateID: 1027024,
+		Time:         1589436922972,
+		TradeTime:    1589436922959,
 		Bids: []Bid{
 			{
 				Price:    "4.00000000",
@@ -59,9 +63,19 @@ func (s *depthServiceTestSuite) TestDepth() {
 	s.assertDepthResponseEqual(e, res)
 }
 
+func (s *depthServiceTestSuite) TestDepthWeight() {
+	r := s.r()
+	r.Equal(10, s.client.NewDepthService().Symbol("BTCUSDT").Weight())
+	r.Equal(2, s.client.NewDepthService().Symbol("BTCUSDT").Limit(5).Weight())
+	r.Equal(5, s.client.NewDepthService().Symbol("BTCUSDT").Limit(100).Weight())
+	r.Equal(20, s.client.NewDepthService().Symbol("BTCUSDT").Limit(1000).Weight())
+}
+
 func (s *depthServiceTestSuite) assertDepthResponseEqual(e, a *DepthResponse) {
 	r := s.r()
 	r.Equal(e.LastUpdateID, a.LastUpdateID, "LastUpdateID")
+	r.Equal(e.Time, a.Time, "Time")
+	r.Equal(e.TradeTime, a.TradeTime, "TradeTime")
 	r.Len(a.Bids, len(e.Bids))
 	for i := 0; i < len(a.Bids); i++ {
 		r.Equal(e.Bids[i].Price, a.Bids[i].Price, "Price")
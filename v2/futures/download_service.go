@@ -0,0 +1,279 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DownloadStatus define the status of an asynchronous history download
+type DownloadStatus string
+
+// Global enums
+const (
+	DownloadStatusCompleted  DownloadStatus = "completed"
+	DownloadStatusProcessing DownloadStatus = "processing"
+)
+
+// DownloadID define the id of a requested history download
+type DownloadID struct {
+	AvgCostTimestampOfLast30d int64  `json:"avgCostTimestampOfLast30d"`
+	DownloadID                string `json:"downloadId"`
+}
+
+// DownloadLink define the status and link of a history download
+type DownloadLink struct {
+	DownloadID          string         `json:"downloadId"`
+	Status              DownloadStatus `json:"status"`
+	URL                 string         `json:"url"`
+	Notified            bool           `json:"notified"`
+	ExpirationTimestamp int64          `json:"expirationTimestamp"`
+	IsExpired           *bool          `json:"isExpired"`
+}
+
+func requestDownload(ctx context.Context, c *Client, endpoint string, startTime, endTime int64, opts ...RequestOption) (res *DownloadID, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("startTime", startTime)
+	r.setParam("endTime", endTime)
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadID)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func getDownloadLink(ctx context.Context, c *Client, endpoint string, downloadID string, opts ...RequestOption) (res *DownloadLink, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("downloadId", downloadID)
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadLink)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// downloadAndWait request a download and poll its link every pollInterval until it is completed or ctx is done
+func downloadAndWait(ctx context.Context, c *Client, endpoint string, startTime, endTime int64, pollInterval time.Duration, opts ...RequestOption) (url string, err error) {
+	if pollInterval <= 0 {
+		return "", fmt.Errorf("%w: pollInterval must be positive", ErrInvalidParam)
+	}
+	id, err := requestDownload(ctx, c, endpoint, startTime, endTime, opts...)
+	if err != nil {
+		return "", err
+	}
+	// a download is never ready right after its request
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-time.After(pollInterval):
+	}
+	link, err := waitDownloadLink(ctx, c, endpoint+"/id", id.DownloadID, pollInterval, opts...)
+	if err != nil {
+		return "", err
+	}
+	return link.URL, nil
+}
+
+// waitDownloadLink poll the link of a download every pollInterval until it is completed or ctx is done
+func waitDownloadLink(ctx context.Context, c *Client, endpoint string, downloadID string, pollInterval time.Duration, opts ...RequestOption) (*DownloadLink, error) {
+	if pollInterval <= 0 {
+		return nil, fmt.Errorf("%w: pollInterval must be positive", ErrInvalidParam)
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		link, err := getDownloadLink(ctx, c, endpoint, downloadID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if link.IsExpired != nil && *link.IsExpired {
+			return nil, fmt.Errorf("download %s expired", downloadID)
+		}
+		if link.Status == DownloadStatusCompleted {
+			return link, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RequestIncomeDownloadService request a download of the income history
+type RequestIncomeDownloadService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// StartTime set startTime
+func (s *RequestIncomeDownloadService) StartTime(startTime int64) *RequestIncomeDownloadService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *RequestIncomeDownloadService) EndTime(endTime int64) *RequestIncomeDownloadService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *RequestIncomeDownloadService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	return requestDownload(ctx, s.c, "/fapi/v1/income/asyn", s.startTime, s.endTime, opts...)
+}
+
+// DownloadAndWait request a download of the income history between the startTime and endTime of the service,
+// poll its link every pollInterval until it is completed and return its url, use ctx for a timeout
+func (s *RequestIncomeDownloadService) DownloadAndWait(ctx context.Context, pollInterval time.Duration, opts ...RequestOption) (url string, err error) {
+	return downloadAndWait(ctx, s.c, "/fapi/v1/income/asyn", s.startTime, s.endTime, pollInterval, opts...)
+}
+
+// GetIncomeDownloadLinkService get the link of an income history download
+type GetIncomeDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// DownloadID set downloadId
+func (s *GetIncomeDownloadLinkService) DownloadID(downloadID string) *GetIncomeDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetIncomeDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	return getDownloadLink(ctx, s.c, "/fapi/v1/income/asyn/id", s.downloadID, opts...)
+}
+
+// RequestOrderDownloadService request a download of the order history
+type RequestOrderDownloadService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// StartTime set startTime
+func (s *RequestOrderDownloadService) StartTime(startTime int64) *RequestOrderDownloadService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *RequestOrderDownloadService) EndTime(endTime int64) *RequestOrderDownloadService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *RequestOrderDownloadService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	return requestDownload(ctx, s.c, "/fapi/v1/order/asyn", s.startTime, s.endTime, opts...)
+}
+
+// DownloadAndWait request a download of the order history between the startTime and endTime of the service,
+// poll its link every pollInterval until it is completed and return its url, use ctx for a timeout
+func (s *RequestOrderDownloadService) DownloadAndWait(ctx context.Context, pollInterval time.Duration, opts ...RequestOption) (url string, err error) {
+	return downloadAndWait(ctx, s.c, "/fapi/v1/order/asyn", s.startTime, s.endTime, pollInterval, opts...)
+}
+
+// GetOrderDownloadLinkService get the link of an order history download
+type GetOrderDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// DownloadID set downloadId
+func (s *GetOrderDownloadLinkService) DownloadID(downloadID string) *GetOrderDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetOrderDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	return getDownloadLink(ctx, s.c, "/fapi/v1/order/asyn/id", s.downloadID, opts...)
+}
+
+// RequestTradeDownloadService request a download of the trade history
+type RequestTradeDownloadService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// GetFuturesTradeDownloadIDService request a download of the trade history, see RequestTradeDownloadService
+type GetFuturesTradeDownloadIDService = RequestTradeDownloadService
+
+// StartTime set startTime
+func (s *RequestTradeDownloadService) StartTime(startTime int64) *RequestTradeDownloadService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *RequestTradeDownloadService) EndTime(endTime int64) *RequestTradeDownloadService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *RequestTradeDownloadService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	return requestDownload(ctx, s.c, "/fapi/v1/trade/asyn", s.startTime, s.endTime, opts...)
+}
+
+// DownloadAndWait request a download of the trade history between the startTime and endTime of the service,
+// poll its link every pollInterval until it is completed and return its url, use ctx for a timeout
+func (s *RequestTradeDownloadService) DownloadAndWait(ctx context.Context, pollInterval time.Duration, opts ...RequestOption) (url string, err error) {
+	return downloadAndWait(ctx, s.c, "/fapi/v1/trade/asyn", s.startTime, s.endTime, pollInterval, opts...)
+}
+
+// GetTradeDownloadLinkService get the link of a trade history download
+type GetTradeDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// GetFuturesTradeDownloadLinkService get the link of a trade history download, see GetTradeDownloadLinkService
+type GetFuturesTradeDownloadLinkService = GetTradeDownloadLinkService
+
+// DownloadID set downloadId
+func (s *GetTradeDownloadLinkService) DownloadID(downloadID string) *GetTradeDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetTradeDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	return getDownloadLink(ctx, s.c, "/fapi/v1/trade/asyn/id", s.downloadID, opts...)
+}
+
+// Wait poll the link every pollInterval, which must be positive, until the download is completed and return it,
+// it gives up after timeout, or when ctx is done if timeout is not positive
+func (s *GetTradeDownloadLinkService) Wait(ctx context.Context, pollInterval, timeout time.Duration, opts ...RequestOption) (res *DownloadLink, err error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return waitDownloadLink(ctx, s.c, "/fapi/v1/trade/asyn/id", s.downloadID, pollInterval, opts...)
+}
@@ -0,0 +1,158 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type downloadServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDownloadService(t *testing.T) {
+	suite.Run(t, new(downloadServiceTestSuite))
+}
+
+func (s *downloadServiceTestSuite) TestRequestIncomeDownload() {
+	data := []byte(`{
+		"avgCostTimestampOfLast30d": 7241837,
+		"downloadId": "546975389218332672"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	startTime := int64(1576756800000)
+	endTime := int64(1579435200000)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewRequestIncomeDownloadService().StartTime(startTime).EndTime(endTime).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&DownloadID{AvgCostTimestampOfLast30d: 7241837, DownloadID: "546975389218332672"}, res)
+}
+
+func (s *downloadServiceTestSuite) TestGetTradeDownloadLink() {
+	data := []byte(`{
+		"downloadId": "545923594199212032",
+		"status": "completed",
+		"url": "www.binance.com",
+		"notified": true,
+		"expirationTimestamp": 1645009771000,
+		"isExpired": null
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	downloadID := "545923594199212032"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("downloadId", downloadID)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetTradeDownloadLinkService().DownloadID(downloadID).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&DownloadLink{
+		DownloadID:          downloadID,
+		Status:              DownloadStatusCompleted,
+		URL:                 "www.binance.com",
+		Notified:            true,
+		ExpirationTimestamp: 1645009771000,
+	}, res)
+}
+
+func TestDownloadAndWait(t *testing.T) {
+	r := require.New(t)
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/fapi/v1/order/asyn":
+			r.Equal("1", req.URL.Query().Get("startTime"))
+			r.Equal("2", req.URL.Query().Get("endTime"))
+			w.Write([]byte(`{"avgCostTimestampOfLast30d": 7241837, "downloadId": "1"}`))
+		case "/fapi/v1/order/asyn/id":
+			r.Equal("1", req.URL.Query().Get("downloadId"))
+			if atomic.AddInt32(&polls, 1) < 3 {
+				w.Write([]byte(`{"downloadId": "1", "status": "processing", "url": "", "notified": false, "expirationTimestamp": -1}`))
+				return
+			}
+			w.Write([]byte(`{"downloadId": "1", "status": "completed", "url": "https://example.com/orders.csv", "notified": true, "expirationTimestamp": 1645009771000}`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	}))
+	defer server.Close()
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	url, err := c.NewRequestOrderDownloadService().StartTime(1).EndTime(2).DownloadAndWait(context.Background(), time.Millisecond)
+	r.NoError(err)
+	r.Equal("https://example.com/orders.csv", url)
+	r.Equal(int32(3), polls)
+
+	_, err = c.NewRequestOrderDownloadService().StartTime(1).EndTime(2).DownloadAndWait(context.Background(), 0)
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Equal(int32(3), polls)
+}
+
+func TestDownloadAndWaitTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/fapi/v1/income/asyn" {
+			w.Write([]byte(`{"downloadId": "1"}`))
+			return
+		}
+		w.Write([]byte(`{"downloadId": "1", "status": "processing"}`))
+	}))
+	defer server.Close()
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := c.NewRequestIncomeDownloadService().StartTime(1).EndTime(2).DownloadAndWait(ctx, time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestTradeDownloadLinkWait(t *testing.T) {
+	r := require.New(t)
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/fapi/v1/trade/asyn/id", req.URL.Path)
+		r.Equal("2", req.URL.Query().Get("downloadId"))
+		if atomic.AddInt32(&polls, 1) < 2 {
+			w.Write([]byte(`{"downloadId": "2", "status": "processing", "url": "", "notified": false, "expirationTimestamp": -1}`))
+			return
+		}
+		w.Write([]byte(`{"downloadId": "2", "status": "completed", "url": "https://example.com/trades.csv", "notified": true, "expirationTimestamp": 1645009771000}`))
+	}))
+	defer server.Close()
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	var s *GetFuturesTradeDownloadLinkService = c.NewGetTradeDownloadLinkService().DownloadID("2")
+	link, err := s.Wait(context.Background(), time.Millisecond, time.Second)
+	r.NoError(err)
+	r.Equal(DownloadStatusCompleted, link.Status)
+	r.Equal("https://example.com/trades.csv", link.URL)
+	r.Equal(int32(2), polls)
+
+	atomic.StoreInt32(&polls, -100)
+	_, err = s.Wait(context.Background(), time.Millisecond, 20*time.Millisecond)
+	r.ErrorIs(err, context.DeadlineExceeded)
+
+	polled := atomic.LoadInt32(&polls)
+	_, err = s.Wait(context.Background(), 0, time.Second)
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Equal(polled, atomic.LoadInt32(&polls))
+}
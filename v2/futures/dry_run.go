@@ -0,0 +1,170 @@
+package futures
+
+import "fmt"
+
+// DryRunOrderID is the order id of the synthetic responses returned in dry run mode
+const DryRunOrderID int64 = -1
+
+// WithDryRun make the services creating and canceling orders validate their parameters and return
+// synthetic responses without sending them: the responses have DryRun set, the created orders have
+// the DryRunOrderID id and the canceled ones are reported as canceled.
+// The created orders are checked against the symbol filters of info too when it is not nil.
+// The other services, including the account and position settings, still send their requests.
+func WithDryRun(info *ExchangeInfo) ClientOption {
+	return func(c *Client) {
+		c.dryRun = true
+		c.dryRunInfo = info
+	}
+}
+
+// checkRequired check the parameters required by the order type are set
+func (s *CreateOrderService) checkRequired() error {
+	missing := func(param string) error {
+		return fmt.Errorf("%w: %s is required by %s orders", ErrInvalidParam, param, s.orderType)
+	}
+	if s.symbol == "" {
+		return fmt.Errorf("%w: symbol is required", ErrInvalidParam)
+	}
+	if s.side == "" {
+		return fmt.Errorf("%w: side is required", ErrInvalidParam)
+	}
+	hasPrice := s.price != nil || s.priceMatch != nil
+	closePosition := s.closePosition != nil && *s.closePosition
+	switch s.orderType {
+	case OrderTypeLimit:
+		if s.timeInForce == nil {
+			return missing("timeInForce")
+		}
+		if s.quantity == "" {
+			return missing("quantity")
+		}
+		if !hasPrice {
+			return missing("price")
+		}
+	case OrderTypeMarket:
+		if s.quantity == "" {
+			return missing("quantity")
+		}
+	case OrderTypeStop, OrderTypeTakeProfit:
+		if s.quantity == "" {
+			return missing("quantity")
+		}
+		if !hasPrice {
+			return missing("price")
+		}
+		if s.stopPrice == nil {
+			return missing("stopPrice")
+		}
+	case OrderTypeStopMarket, OrderTypeTakeProfitMarket:
+		if s.stopPrice == nil {
+			return missing("stopPrice")
+		}
+		if s.quantity == "" && !closePosition {
+			return missing("quantity")
+		}
+	case OrderTypeTrailingStopMarket:
+		if s.quantity == "" {
+			return missing("quantity")
+		}
+		if s.callbackRate == nil {
+			return missing("callbackRate")
+		}
+	default:
+		return fmt.Errorf("%w: invalid type %q", ErrInvalidParam, s.orderType)
+	}
+	return nil
+}
+
+// dryRunResponse return the synthetic response of the order in dry run mode
+func (s *CreateOrderService) dryRunResponse() *CreateOrderResponse {
+	res := &CreateOrderResponse{
+		DryRun:       true,
+		Symbol:       s.symbol,
+		OrderID:      DryRunOrderID,
+		OrigQuantity: s.quantity,
+		Status:       OrderStatusTypeNew,
+		Type:         s.orderType,
+		Side:         s.side,
+		PositionSide: PositionSideTypeBoth,
+	}
+	if s.newClientOrderID != nil {
+		res.ClientOrderID = *s.newClientOrderID
+	}
+	if s.price != nil {
+		res.Price = *s.price
+	}
+	if s.stopPrice != nil {
+		res.StopPrice = *s.stopPrice
+	}
+	if s.timeInForce != nil {
+		res.TimeInForce = *s.timeInForce
+	}
+	if s.positionSide != nil {
+		res.PositionSide = *s.positionSide
+	}
+	if s.reduceOnly != nil {
+		res.ReduceOnly = *s.reduceOnly
+	}
+	if s.closePosition != nil {
+		res.ClosePosition = *s.closePosition
+	}
+	if s.goodTillDate != nil {
+		res.GoodTillDate = *s.goodTillDate
+	}
+	return res
+}
+
+// dryRunResponse return the synthetic response of the cancelation in dry run mode
+func (s *CancelOrderService) dryRunResponse() (*CancelOrderResponse, error) {
+	if s.symbol == "" {
+		return nil, fmt.Errorf("%w: symbol is required", ErrInvalidParam)
+	}
+	if s.orderID == nil && s.origClientOrderID == nil {
+		return nil, fmt.Errorf("%w: either orderId or origClientOrderId must be sent", ErrInvalidParam)
+	}
+	res := &CancelOrderResponse{
+		DryRun:  true,
+		Symbol:  s.symbol,
+		OrderID: DryRunOrderID,
+		Status:  OrderStatusTypeCanceled,
+	}
+	if s.orderID != nil {
+		res.OrderID = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		res.ClientOrderID = *s.origClientOrderID
+	}
+	return res, nil
+}
+
+// dryRunResponse return the synthetic response of the cancelation in dry run mode
+func (s *CancelAllFuturesOpenOrdersService) dryRunResponse() (*CancelAllOpenOrdersResponse, error) {
+	if s.symbol == "" {
+		return nil, fmt.Errorf("%w: symbol is required", ErrInvalidParam)
+	}
+	return &CancelAllOpenOrdersResponse{
+		DryRun: true,
+		Code:   "200",
+		Msg:    "The operation of cancel all open order is done.",
+	}, nil
+}
+
+// dryRunResponse return the synthetic responses of the cancelations in dry run mode
+func (s *CancelMultiplesOrdersService) dryRunResponse() ([]*CancelOrderResponse, error) {
+	if s.symbol == "" {
+		return nil, fmt.Errorf("%w: symbol is required", ErrInvalidParam)
+	}
+	if len(s.orderIDList) == 0 && len(s.origClientOrderIDList) == 0 {
+		return nil, fmt.Errorf("%w: either orderIdList or origClientOrderIdList must be sent", ErrInvalidParam)
+	}
+	res := make([]*CancelOrderResponse, 0, len(s.orderIDList)+len(s.origClientOrderIDList))
+	for _, id := range s.orderIDList {
+		res = append(res, &CancelOrderResponse{DryRun: true, Symbol: s.symbol, OrderID: id, Status: OrderStatusTypeCanceled})
+	}
+	for _, id := range s.origClientOrderIDList {
+		res = append(res, &CancelOrderResponse{
+			DryRun: true, Symbol: s.symbol, OrderID: DryRunOrderID, ClientOrderID: id, Status: OrderStatusTypeCanceled,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,147 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newDryRunClient(t *testing.T, info *ExchangeInfo) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request %s in dry run mode", req.URL.Path)
+	}))
+	t.Cleanup(server.Close)
+	c := NewClient("", "", WithDryRun(info))
+	c.BaseURL = server.URL
+	return c
+}
+
+func TestDryRunCreateOrder(t *testing.T) {
+	r := require.New(t)
+	c := newDryRunClient(t, nil)
+
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.01").Price("30000").NewClientOrderID("strategy-1").
+		Do(context.Background())
+	r.NoError(err)
+	r.Equal(&CreateOrderResponse{
+		DryRun:        true,
+		Symbol:        "BTCUSDT",
+		OrderID:       DryRunOrderID,
+		ClientOrderID: "strategy-1",
+		Price:         "30000",
+		OrigQuantity:  "0.01",
+		Status:        OrderStatusTypeNew,
+		TimeInForce:   TimeInForceTypeGTC,
+		Type:          OrderTypeLimit,
+		Side:          SideTypeBuy,
+		PositionSide:  PositionSideTypeBoth,
+	}, res)
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		Quantity("0.01").Price("30000").Do(context.Background())
+	r.ErrorIs(err, ErrInvalidParam)
+	r.EqualError(err, "invalid parameter: timeInForce is required by LIMIT orders")
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeStopMarket).
+		Quantity("0.01").Do(context.Background())
+	r.EqualError(err, "invalid parameter: stopPrice is required by STOP_MARKET orders")
+
+	res, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeStopMarket).
+		StopPrice("29000").ClosePosition(true).Do(context.Background())
+	r.NoError(err)
+	r.True(res.DryRun)
+	r.True(res.ClosePosition)
+}
+
+func TestDryRunCreateOrderFilters(t *testing.T) {
+	r := require.New(t)
+	info := &ExchangeInfo{
+		Symbols: []Symbol{
+			{
+				Symbol: "BTCUSDT",
+				Filters: []map[string]interface{}{
+					{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+					{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+				},
+			},
+		},
+	}
+	c := newDryRunClient(t, info)
+
+	_, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.0015").Price("30000").Do(context.Background())
+	r.True(IsFilterViolationError(err))
+
+	res, err := c.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).Quantity("0.001"),
+		c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+			TimeInForce(TimeInForceTypeGTC).Quantity("0.001").Price("31000"),
+	}).Do(context.Background())
+	r.NoError(err)
+	r.Len(res.Orders, 2)
+	for _, o := range res.Orders {
+		r.Equal(DryRunOrderID, o.OrderID)
+		r.Equal(OrderStatusTypeNew, o.Status)
+	}
+
+	_, err = c.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		c.NewCreateOrderService().Symbol("ETHUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).Quantity("0.001"),
+	}).Do(context.Background())
+	r.EqualError(err, "symbol ETHUSDT not found in exchange info")
+}
+
+func TestDryRunCancelOrders(t *testing.T) {
+	r := require.New(t)
+	c := newDryRunClient(t, nil)
+	ctx := context.Background()
+
+	res, err := c.NewCancelOrderService().Symbol("BTCUSDT").OrderID(42).Do(ctx)
+	r.NoError(err)
+	r.Equal(&CancelOrderResponse{DryRun: true, Symbol: "BTCUSDT", OrderID: 42, Status: OrderStatusTypeCanceled}, res)
+	_, err = c.NewCancelOrderService().Symbol("BTCUSDT").Do(ctx)
+	r.ErrorIs(err, ErrInvalidParam)
+
+	all, err := c.NewCancelAllFuturesOpenOrdersService().Symbol("BTCUSDT").Do(ctx)
+	r.NoError(err)
+	r.True(all.DryRun)
+	r.NoError(c.NewCancelAllOpenOrdersService().Symbol("BTCUSDT").Do(ctx))
+	r.ErrorIs(c.NewCancelAllOpenOrdersService().Do(ctx), ErrInvalidParam)
+
+	list, err := c.NewCancelMultipleOrdersService().Symbol("BTCUSDT").OrderIDList([]int64{1, 2}).
+		OrigClientOrderIDList([]string{"a"}).Do(ctx)
+	r.NoError(err)
+	r.Equal([]*CancelOrderResponse{
+		{DryRun: true, Symbol: "BTCUSDT", OrderID: 1, Status: OrderStatusTypeCanceled},
+		{DryRun: true, Symbol: "BTCUSDT", OrderID: 2, Status: OrderStatusTypeCanceled},
+		{DryRun: true, Symbol: "BTCUSDT", OrderID: DryRunOrderID, ClientOrderID: "a", Status: OrderStatusTypeCanceled},
+	}, list)
+	_, err = c.NewCancelMultipleOrdersService().Symbol("BTCUSDT").Do(ctx)
+	r.ErrorIs(err, ErrInvalidParam)
+}
+
+func TestDryRunGoodTillDate(t *testing.T) {
+	r := require.New(t)
+	c := newDryRunClient(t, nil)
+	order := func() *CreateOrderService {
+		return c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+			Quantity("0.01").Price("30000")
+	}
+	goodTillDate := time.Now().Add(time.Hour).UnixNano() / int64(time.Millisecond)
+
+	res, err := order().TimeInForce(TimeInForceTypeGTD).GoodTillDate(goodTillDate).Do(context.Background())
+	r.NoError(err)
+	r.Equal(goodTillDate, res.GoodTillDate)
+
+	_, err = order().TimeInForce(TimeInForceTypeGTD).Do(context.Background())
+	r.EqualError(err, "invalid parameter: goodTillDate is required by timeInForce GTD")
+	_, err = order().TimeInForce(TimeInForceTypeGTC).GoodTillDate(goodTillDate).Do(context.Background())
+	r.EqualError(err, "invalid parameter: goodTillDate can only be sent with timeInForce GTD")
+	_, err = order().TimeInForce(TimeInForceTypeGTD).GoodTillDate(time.Now().UnixNano() / int64(time.Millisecond)).
+		Do(context.Background())
+	r.EqualError(err, "invalid parameter: goodTillDate must be at least 600 seconds in the future")
+}
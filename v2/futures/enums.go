@@ -0,0 +1,191 @@
+package futures
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsValid tell if t is a known order side
+func (t SideType) IsValid() bool {
+	switch t {
+	case SideTypeBuy, SideTypeSell:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known position side
+func (t PositionSideType) IsValid() bool {
+	switch t {
+	case PositionSideTypeBoth, PositionSideTypeLong, PositionSideTypeShort:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known order type
+func (t OrderType) IsValid() bool {
+	switch t {
+	case OrderTypeLimit, OrderTypeMarket, OrderTypeStop, OrderTypeStopMarket,
+		OrderTypeTakeProfit, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known time in force
+func (t TimeInForceType) IsValid() bool {
+	switch t {
+	case TimeInForceTypeGTC, TimeInForceTypeIOC, TimeInForceTypeFOK, TimeInForceTypeGTX, TimeInForceTypeGTD:
+		return true
+	}
+	return false
+}
+
+// ParseSideType return the order side named s, case insensitive, or the zero value and an error wrapping ErrInvalidParam
+func ParseSideType(s string) (SideType, error) {
+	t := SideType(strings.ToUpper(s))
+	if err := validEnum("side", t); err != nil {
+		return "", err
+	}
+	return t, nil
+}
+
+// ParsePositionSideType return the position side named s, case insensitive, or the zero value and an error wrapping ErrInvalidParam
+func ParsePositionSideType(s string) (PositionSideType, error) {
+	t := PositionSideType(strings.ToUpper(s))
+	if err := validEnum("positionSide", t); err != nil {
+		return "", err
+	}
+	return t, nil
+}
+
+// ParseOrderType return the order type named s, case insensitive, or the zero value and an error wrapping ErrInvalidParam
+func ParseOrderType(s string) (OrderType, error) {
+	t := OrderType(strings.ToUpper(s))
+	if err := validEnum("type", t); err != nil {
+		return "", err
+	}
+	return t, nil
+}
+
+// ParseTimeInForce return the time in force named s, case insensitive, or the zero value and an error wrapping ErrInvalidParam
+func ParseTimeInForce(s string) (TimeInForceType, error) {
+	t := TimeInForceType(strings.ToUpper(s))
+	if err := validEnum("timeInForce", t); err != nil {
+		return "", err
+	}
+	return t, nil
+}
+
+// IsValid tell if t is a known working type
+func (t WorkingType) IsValid() bool {
+	switch t {
+	case WorkingTypeMarkPrice, WorkingTypeContractPrice:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known new order response type
+func (t NewOrderRespType) IsValid() bool {
+	switch t {
+	case NewOrderRespTypeACK, NewOrderRespTypeRESULT:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known price match type
+func (t PriceMatchType) IsValid() bool {
+	switch t {
+	case PriceMatchTypeOpponent, PriceMatchTypeOpponent5, PriceMatchTypeOpponent10, PriceMatchTypeOpponent20,
+		PriceMatchTypeQueue, PriceMatchTypeQueue5, PriceMatchTypeQueue10, PriceMatchTypeQueue20:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if m is a known self trade prevention mode
+func (m STPMode) IsValid() bool {
+	switch m {
+	case STPModeExpireTaker, STPModeExpireMaker, STPModeExpireBoth, STPModeNone:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known margin type
+func (t MarginType) IsValid() bool {
+	switch t {
+	case MarginTypeIsolated, MarginTypeCrossed:
+		return true
+	}
+	return false
+}
+
+// IsUserDataValid tell if t is a known margin type of the positions of the user data events,
+// which is one of the upper case margin types or their lower case ACCOUNT_UPDATE form
+func (t MarginType) IsUserDataValid() bool {
+	switch t {
+	case MarginTypeIsolated, MarginTypeCrossed, MarginTypeUserDataIsolated, MarginTypeUserDataCross:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known reason of an ACCOUNT_UPDATE user data event
+func (t UserDataEventReasonType) IsValid() bool {
+	switch t {
+	case UserDataEventReasonTypeDeposit, UserDataEventReasonTypeWithdraw, UserDataEventReasonTypeOrder,
+		UserDataEventReasonTypeFundingFee, UserDataEventReasonTypeWithdrawReject, UserDataEventReasonTypeAdjustment,
+		UserDataEventReasonTypeInsuranceClear, UserDataEventReasonTypeAdminDeposit, UserDataEventReasonTypeAdminWithdraw,
+		UserDataEventReasonTypeMarginTransfer, UserDataEventReasonTypeMarginTypeChange, UserDataEventReasonTypeAssetTransfer,
+		UserDataEventReasonTypeOptionsPremiumFee, UserDataEventReasonTypeOptionsSettleProfit, UserDataEventReasonTypeAutoExchange,
+		UserDataEventReasonTypeCoinSwapDeposit, UserDataEventReasonTypeCoinSwapWithdraw:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known contract type
+func (t ContractType) IsValid() bool {
+	switch t {
+	case ContractTypePerpetual, ContractTypeCurrentMonth, ContractTypeNextMonth,
+		ContractTypeCurrentQuarter, ContractTypeNextQuarter, ContractTypePerpetualDelivering:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known income type
+func (t IncomeType) IsValid() bool {
+	switch t {
+	case IncomeTypeTransfer, IncomeTypeWelcomeBonus, IncomeTypeRealizedPnl, IncomeTypeFundingFee,
+		IncomeTypeCommission, IncomeTypeInsuranceClear, IncomeTypeReferralKickback, IncomeTypeCommissionRebate,
+		IncomeTypeAPIRebate, IncomeTypeContestReward, IncomeTypeCrossCollateralTransfer, IncomeTypeOptionsPremiumFee,
+		IncomeTypeOptionsSettleProfit, IncomeTypeInternalTransfer, IncomeTypeAutoExchange, IncomeTypeDeliveredSettlement,
+		IncomeTypeCoinSwapDeposit, IncomeTypeCoinSwapWithdraw, IncomeTypePositionLimitIncreaseFee:
+		return true
+	}
+	return false
+}
+
+// IsValid tell if t is a known statistics period
+func (t PeriodType) IsValid() bool {
+	switch t {
+	case Period5m, Period15m, Period30m, Period1h, Period2h, Period4h, Period6h, Period12h, Period1d:
+		return true
+	}
+	return false
+}
+
+// validEnum return an error wrapping ErrInvalidParam and naming the parameter when value is unknown
+func validEnum(param string, value interface {
+	IsValid() bool
+}) error {
+	if !value.IsValid() {
+		return fmt.Errorf("%w: invalid %s %v", ErrInvalidParam, param, value)
+	}
+	return nil
+}
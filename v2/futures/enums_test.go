@@ -0,0 +1,119 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type enumsTestSuite struct {
+	baseTestSuite
+}
+
+func TestEnums(t *testing.T) {
+	suite.Run(t, new(enumsTestSuite))
+}
+
+func (s *enumsTestSuite) TestIsValid() {
+	r := s.r()
+	r.True(WorkingTypeMarkPrice.IsValid())
+	r.False(WorkingType("LAST_PRICE").IsValid())
+	r.True(NewOrderRespTypeRESULT.IsValid())
+	r.False(NewOrderRespType("FULL").IsValid())
+	r.True(PriceMatchTypeQueue20.IsValid())
+	r.False(PriceMatchType("QUEUE_30").IsValid())
+	r.True(STPModeNone.IsValid())
+	r.False(STPMode("DECREMENT").IsValid())
+	r.True(MarginTypeCrossed.IsValid())
+	r.False(MarginType("CROSS").IsValid())
+	r.True(ContractTypeCurrentQuarter.IsValid())
+	r.False(ContractType("QUARTER").IsValid())
+	r.True(IncomeTypeFundingFee.IsValid())
+	r.False(IncomeType("FUNDING").IsValid())
+	r.True(Period4h.IsValid())
+	r.False(PeriodType("3h").IsValid())
+	r.True(SideTypeSell.IsValid())
+	r.False(SideType("sell").IsValid())
+	r.True(PositionSideTypeLong.IsValid())
+	r.False(PositionSideType("HEDGE").IsValid())
+	r.True(OrderTypeTrailingStopMarket.IsValid())
+	r.False(OrderType("LIMIT_MAKER").IsValid())
+	r.True(TimeInForceTypeGTD.IsValid())
+	r.False(TimeInForceType("gtc").IsValid())
+}
+
+func (s *enumsTestSuite) TestUserDataIsValid() {
+	r := s.r()
+	for _, reason := range []string{
+		"DEPOSIT", "WITHDRAW", "ORDER", "FUNDING_FEE", "WITHDRAW_REJECT", "ADJUSTMENT", "INSURANCE_CLEAR",
+		"ADMIN_DEPOSIT", "ADMIN_WITHDRAW", "MARGIN_TRANSFER", "MARGIN_TYPE_CHANGE", "ASSET_TRANSFER",
+		"OPTIONS_PREMIUM_FEE", "OPTIONS_SETTLE_PROFIT", "AUTO_EXCHANGE", "COIN_SWAP_DEPOSIT", "COIN_SWAP_WITHDRAW",
+	} {
+		r.True(UserDataEventReasonType(reason).IsValid(), reason)
+	}
+	r.False(UserDataEventReasonType("order").IsValid())
+	r.False(UserDataEventReasonType("").IsValid())
+
+	for _, marginType := range []string{"isolated", "cross", "ISOLATED", "CROSSED"} {
+		r.True(MarginType(marginType).IsUserDataValid(), marginType)
+	}
+	r.False(MarginType("crossed").IsUserDataValid())
+	r.False(MarginTypeUserDataCross.IsValid())
+
+	for _, side := range []string{"BOTH", "LONG", "SHORT"} {
+		r.True(PositionSideType(side).IsValid(), side)
+	}
+	r.False(PositionSideType("long").IsValid())
+}
+
+func (s *enumsTestSuite) TestParse() {
+	r := s.r()
+	tif, err := ParseTimeInForce("gtc")
+	r.NoError(err)
+	r.Equal(TimeInForceTypeGTC, tif)
+	tif, err = ParseTimeInForce("DAY")
+	r.ErrorIs(err, ErrInvalidParam)
+	r.EqualError(err, "invalid parameter: invalid timeInForce DAY")
+	r.Empty(tif)
+
+	orderType, err := ParseOrderType("stop_market")
+	r.NoError(err)
+	r.Equal(OrderTypeStopMarket, orderType)
+	orderType, err = ParseOrderType("STOP_LOSS")
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Empty(orderType)
+
+	side, err := ParseSideType("Buy")
+	r.NoError(err)
+	r.Equal(SideTypeBuy, side)
+	side, err = ParseSideType("LONG")
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Empty(side)
+
+	positionSide, err := ParsePositionSideType("short")
+	r.NoError(err)
+	r.Equal(PositionSideTypeShort, positionSide)
+	positionSide, err = ParsePositionSideType("SELL")
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Empty(positionSide)
+}
+
+func (s *enumsTestSuite) TestInvalidParams() {
+	r := s.r()
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeStopMarket).
+		StopPrice("9000").Quantity("1").WorkingType("LAST_PRICE").Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	r.EqualError(err, "invalid parameter: invalid workingType LAST_PRICE")
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce("gtc").Price("9000").Quantity("1").Do(newContext())
+	r.EqualError(err, "invalid parameter: invalid timeInForce gtc")
+
+	err = s.client.NewChangeMarginTypeService().Symbol("BTCUSDT").MarginType("CROSS").Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Contains(err.Error(), "marginType")
+
+	_, err = s.client.NewOpenInterestStatisticsService().Symbol("BTCUSDT").Period("3h").Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Contains(err.Error(), "period")
+}
@@ -12,6 +12,9 @@ type ExchangeInfoService struct {
 	c *Client
 }
 
+// GetFuturesExchangeInfoService exchange info service, see ExchangeInfoService
+type GetFuturesExchangeInfoService = ExchangeInfoService
+
 // Do send request
 func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *ExchangeInfo, err error) {
 	r := &request{
@@ -28,7 +31,7 @@ func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (re
 	if err != nil {
 		return nil, err
 	}
-
+	res.indexSymbols()
 	return res, nil
 }
 
@@ -38,7 +41,46 @@ type ExchangeInfo struct {
 	ServerTime      int64         `json:"serverTime"`
 	RateLimits      []RateLimit   `json:"rateLimits"`
 	ExchangeFilters []interface{} `json:"exchangeFilters"`
+	Assets          []Asset       `json:"assets"`
 	Symbols         []Symbol      `json:"symbols"`
+
+	symbolIndex map[string]int
+}
+
+// FuturesExchangeInfo exchange info, see ExchangeInfo
+type FuturesExchangeInfo = ExchangeInfo
+
+// FuturesSymbolInfo market symbol, see Symbol
+type FuturesSymbolInfo = Symbol
+
+// Asset define an asset of the exchange
+type Asset struct {
+	Asset string `json:"asset"`
+	// MarginAvailable is whether the asset can be used as margin in Multi-Assets mode
+	MarginAvailable bool `json:"marginAvailable"`
+	// AutoAssetExchange is the auto-exchange threshold in Multi-Assets margin mode
+	AutoAssetExchange string `json:"autoAssetExchange"`
+}
+
+func (e *ExchangeInfo) indexSymbols() {
+	e.symbolIndex = make(map[string]int, len(e.Symbols))
+	for i, symbol := range e.Symbols {
+		e.symbolIndex[symbol.Symbol] = i
+	}
+}
+
+// Symbol return the symbol of name, the lookup uses an index built when the exchange info is received
+// and falls back to a scan of Symbols when they were changed since
+func (e *ExchangeInfo) Symbol(name string) (*Symbol, bool) {
+	if i, ok := e.symbolIndex[name]; ok && i < len(e.Symbols) && e.Symbols[i].Symbol == name {
+		return &e.Symbols[i], true
+	}
+	for i := range e.Symbols {
+		if e.Symbols[i].Symbol == name {
+			return &e.Symbols[i], true
+		}
+	}
+	return nil, false
 }
 
 // RateLimit struct
@@ -199,6 +199,66 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 	s.assertPercentPriceFilterEqual(ePercentPriceFilter, res.Symbols[0].PercentPriceFilter())
 }
 
+func (s *exchangeInfoServiceTestSuite) TestGetFuturesExchangeInfo() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1565613908500,
+		"rateLimits": [],
+		"exchangeFilters": [],
+		"assets": [
+			{"asset": "USDT", "marginAvailable": true, "autoAssetExchange": "0"},
+			{"asset": "BNB", "marginAvailable": false, "autoAssetExchange": null}
+		],
+		"symbols": [
+			{"symbol": "BTCUSDT", "pair": "BTCUSDT", "contractType": "PERPETUAL", "pricePrecision": 2},
+			{"symbol": "ETHUSDT_231229", "pair": "ETHUSDT", "contractType": "CURRENT_QUARTER", "deliveryDate": 1703836800000}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+	var res *FuturesExchangeInfo
+	res, err := s.client.NewExchangeInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]Asset{
+		{Asset: "USDT", MarginAvailable: true, AutoAssetExchange: "0"},
+		{Asset: "BNB"},
+	}, res.Assets)
+
+	var symbol *FuturesSymbolInfo
+	symbol, ok := res.Symbol("ETHUSDT_231229")
+	r.True(ok)
+	r.Equal(ContractTypeCurrentQuarter, symbol.ContractType)
+	r.Equal(int64(1703836800000), symbol.DeliveryDate)
+	r.Same(&res.Symbols[1], symbol)
+	symbol, ok = res.Symbol("BTCUSDT")
+	r.True(ok)
+	r.Equal(2, symbol.PricePrecision)
+	_, ok = res.Symbol("btcusdt")
+	r.False(ok)
+	// symbols changed after the exchange info is received are not indexed
+	res.Symbols = append(res.Symbols[:0], res.Symbols[1], Symbol{Symbol: "SOLUSDT"})
+	symbol, ok = res.Symbol("SOLUSDT")
+	r.True(ok)
+	r.Same(&res.Symbols[1], symbol)
+	symbol, ok = res.Symbol("ETHUSDT_231229")
+	r.True(ok)
+	r.Same(&res.Symbols[0], symbol)
+	_, ok = res.Symbol("BTCUSDT")
+	r.False(ok)
+
+	info := &ExchangeInfo{Symbols: []Symbol{{Symbol: "BTCUSDT"}}}
+	symbol, ok = info.Symbol("BTCUSDT")
+	r.True(ok)
+	r.Same(&info.Symbols[0], symbol)
+	_, ok = info.Symbol("ETHUSDT")
+	r.False(ok)
+}
+
 func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInfo) {
 	r := s.r()
 
@@ -212,6 +272,7 @@ func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInf
 		r.Equal(e.RateLimits[i].IntervalNum, a.RateLimits[i].IntervalNum, "IntervalNum")
 	}
 	r.Equal(e.ExchangeFilters, a.ExchangeFilters, "ExchangeFilters")
+	r.Equal(e.Assets, a.Assets, "Assets")
 	r.Len(a.Symbols, len(e.Symbols), "Symbols")
 
 	for i := range a.Symbols {
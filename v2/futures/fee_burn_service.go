@@ -0,0 +1,75 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+)
+
+// ChangeFeeBurnService toggle the BNB fee discount of the account, paying the trading fees in BNB
+type ChangeFeeBurnService struct {
+	c       *Client
+	feeBurn bool
+}
+
+// FeeBurn set feeBurn, true to enable the BNB fee discount
+func (s *ChangeFeeBurnService) FeeBurn(feeBurn bool) *ChangeFeeBurnService {
+	s.feeBurn = feeBurn
+	return s
+}
+
+// Do send request
+func (s *ChangeFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/fapi/v1/feeBurn",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"feeBurn": s.feeBurn,
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return err
+	}
+	res := new(common.APIError)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+	if res.Code != http.StatusOK {
+		return res
+	}
+	return nil
+}
+
+// GetFeeBurnService get the BNB fee discount status of the account
+type GetFeeBurnService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (res *FeeBurn, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/feeBurn",
+		secType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(FeeBurn)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// FeeBurn define the BNB fee discount status
+type FeeBurn struct {
+	FeeBurn bool `json:"feeBurn"`
+}
@@ -0,0 +1,64 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+)
+
+type feeBurnServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestFeeBurnService(t *testing.T) {
+	suite.Run(t, new(feeBurnServiceTestSuite))
+}
+
+func (s *feeBurnServiceTestSuite) TestChangeFeeBurn() {
+	data := []byte(`{
+		"code": 200,
+		"msg": "success"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"feeBurn": true,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewChangeFeeBurnService().FeeBurn(true).Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *feeBurnServiceTestSuite) TestChangeFeeBurnFailure() {
+	data := []byte(`{
+		"code": -1000,
+		"msg": "An unknown error occured while processing the request."
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	err := s.client.NewChangeFeeBurnService().FeeBurn(false).Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.True(common.IsAPIError(err))
+	r.Equal(int64(-1000), err.(*common.APIError).Code)
+}
+
+func (s *feeBurnServiceTestSuite) TestGetFeeBurn() {
+	data := []byte(`{
+		"feeBurn": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetFeeBurnService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&FeeBurn{FeeBurn: true}, res)
+}
@@ -10,7 +10,7 @@ import (
 type GetIncomeHistoryService struct {
 	c          *Client
 	symbol     string
-	incomeType string
+	incomeType IncomeType
 	startTime  *int64
 	endTime    *int64
 	limit      *int64
@@ -22,9 +22,9 @@ func (s *GetIncomeHistoryService) Symbol(symbol string) *GetIncomeHistoryService
 	return s
 }
 
-// IncomeType set income type
+// IncomeType set income type, one of the IncomeType values
 func (s *GetIncomeHistoryService) IncomeType(incomeType string) *GetIncomeHistoryService {
-	s.incomeType = incomeType
+	s.incomeType = IncomeType(incomeType)
 	return s
 }
 
@@ -48,6 +48,10 @@ func (s *GetIncomeHistoryService) Limit(limit int64) *GetIncomeHistoryService {
 
 // Do send request
 func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*IncomeHistory, err error) {
+	// binance adds income types regularly, an unknown one is only logged
+	if s.incomeType != "" && !s.incomeType.IsValid() {
+		s.c.logger().Info("unknown income type, the request is sent anyway", "incomeType", s.incomeType)
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/income",
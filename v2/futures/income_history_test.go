@@ -61,6 +61,24 @@ func (s *incomeHistoryServiceTestSuite) TestIncomeHistory() {
 	s.assertOrderEqual(e, orders[0])
 }
 
+func (s *incomeHistoryServiceTestSuite) TestUnknownIncomeType() {
+	s.mockDo([]byte(`[]`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":     "BTCUSDT",
+			"incomeType": "FEE_RETURN",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetIncomeHistoryService().Symbol("BTCUSDT").IncomeType("FEE_RETURN").
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 0)
+}
+
 func (s *incomeHistoryServiceTestSuite) assertOrderEqual(e, a *IncomeHistory) {
 	r := s.r()
 	r.Equal(e.Income, a.Income, "Income")
@@ -0,0 +1,109 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetIndexInfoService get the composition of the composite indices
+type GetIndexInfoService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, to get the composition of the index of the symbol only
+func (s *GetIndexInfoService) Symbol(symbol string) *GetIndexInfoService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetIndexInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*IndexInfo, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/indexInfo",
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*IndexInfo{}, err
+	}
+	res = make([]*IndexInfo, 0)
+	if s.symbol != nil {
+		// a single index is returned for a symbol
+		info := new(IndexInfo)
+		err = json.Unmarshal(data, info)
+		res = append(res, info)
+	} else {
+		err = json.Unmarshal(data, &res)
+	}
+	if err != nil {
+		return []*IndexInfo{}, err
+	}
+	return res, nil
+}
+
+// IndexInfo define the composition of a composite index
+type IndexInfo struct {
+	Symbol        string                `json:"symbol"`
+	Time          int64                 `json:"time"`
+	Component     string                `json:"component"`
+	BaseAssetList []*IndexInfoBaseAsset `json:"baseAssetList"`
+}
+
+// IndexInfoBaseAsset define the weight of a component of a composite index
+type IndexInfoBaseAsset struct {
+	BaseAsset          string `json:"baseAsset"`
+	QuoteAsset         string `json:"quoteAsset"`
+	WeightInQuantity   string `json:"weightInQuantity"`
+	WeightInPercentage string `json:"weightInPercentage"`
+}
+
+// GetConstituentsService get the constituents of the index price of a symbol
+type GetConstituentsService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetConstituentsService) Symbol(symbol string) *GetConstituentsService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetConstituentsService) Do(ctx context.Context, opts ...RequestOption) (res *Constituents, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/constituents",
+	}
+	r.setParam("symbol", s.symbol)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Constituents)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Constituents define the constituents of the index price of a symbol
+type Constituents struct {
+	Symbol       string         `json:"symbol"`
+	Time         int64          `json:"time"`
+	Constituents []*Constituent `json:"constituents"`
+}
+
+// Constituent define the price and weight of an exchange in an index price
+type Constituent struct {
+	Exchange string `json:"exchange"`
+	Symbol   string `json:"symbol"`
+	Price    string `json:"price"`
+	Weight   string `json:"weight"`
+}
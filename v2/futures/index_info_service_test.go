@@ -0,0 +1,128 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type indexInfoServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIndexInfoService(t *testing.T) {
+	suite.Run(t, new(indexInfoServiceTestSuite))
+}
+
+func (s *indexInfoServiceTestSuite) TestGetIndexInfo() {
+	data := []byte(`[
+		{
+			"symbol": "DEFIUSDT",
+			"time": 1589437530011,
+			"component": "baseAsset",
+			"baseAssetList": [
+				{
+					"baseAsset": "BAL",
+					"quoteAsset": "USDT",
+					"weightInQuantity": "1.04406228",
+					"weightInPercentage": "0.02783900"
+				},
+				{
+					"baseAsset": "BAND",
+					"quoteAsset": "USDT",
+					"weightInQuantity": "3.53782729",
+					"weightInPercentage": "0.03935200"
+				}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newRequest(), r)
+	})
+	res, err := s.client.NewGetIndexInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*IndexInfo{
+		{
+			Symbol:    "DEFIUSDT",
+			Time:      1589437530011,
+			Component: "baseAsset",
+			BaseAssetList: []*IndexInfoBaseAsset{
+				{BaseAsset: "BAL", QuoteAsset: "USDT", WeightInQuantity: "1.04406228", WeightInPercentage: "0.02783900"},
+				{BaseAsset: "BAND", QuoteAsset: "USDT", WeightInQuantity: "3.53782729", WeightInPercentage: "0.03935200"},
+			},
+		},
+	}, res)
+}
+
+func (s *indexInfoServiceTestSuite) TestGetSymbolIndexInfo() {
+	data := []byte(`{
+		"symbol": "DEFIUSDT",
+		"time": 1589437530011,
+		"component": "baseAsset",
+		"baseAssetList": [
+			{
+				"baseAsset": "BAL",
+				"quoteAsset": "USDT",
+				"weightInQuantity": "1.04406228",
+				"weightInPercentage": "0.02783900"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "DEFIUSDT"
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newRequest().setParam("symbol", symbol), r)
+	})
+	res, err := s.client.NewGetIndexInfoService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal("DEFIUSDT", res[0].Symbol)
+	r.Len(res[0].BaseAssetList, 1)
+	r.Equal("1.04406228", res[0].BaseAssetList[0].WeightInQuantity)
+}
+
+func (s *indexInfoServiceTestSuite) TestGetConstituents() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"time": 1745401553408,
+		"constituents": [
+			{
+				"exchange": "binance",
+				"symbol": "BTCUSDT",
+				"price": "94057.03000000",
+				"weight": "0.51282051"
+			},
+			{
+				"exchange": "coinbase",
+				"symbol": "BTC-USDT",
+				"price": "94140.58000000",
+				"weight": "0.15384615"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newRequest().setParam("symbol", symbol), r)
+	})
+	res, err := s.client.NewGetConstituentsService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&Constituents{
+		Symbol: "BTCUSDT",
+		Time:   1745401553408,
+		Constituents: []*Constituent{
+			{Exchange: "binance", Symbol: "BTCUSDT", Price: "94057.03000000", Weight: "0.51282051"},
+			{Exchange: "coinbase", Symbol: "BTC-USDT", Price: "94140.58000000", Weight: "0.15384615"},
+		},
+	}, res)
+}
@@ -6,7 +6,8 @@ import (
 	"net/http"
 )
 
-// IndexPriceKlinesService list klines
+// IndexPriceKlinesService list index price klines of a pair, see MarkPriceKlinesService for the
+// mark price klines of a symbol of the pair
 type IndexPriceKlinesService struct {
 	c         *Client
 	pair      string
@@ -90,3 +91,6 @@ func (ipks *IndexPriceKlinesService) Do(ctx context.Context, opts ...RequestOpti
 	}
 	return res, nil
 }
+
+// GetIndexPriceKlineService list index price klines, see IndexPriceKlinesService
+type GetIndexPriceKlineService = IndexPriceKlinesService
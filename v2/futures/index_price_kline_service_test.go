@@ -48,14 +48,14 @@ func (s *indexPriceKlineServiceTestSuite) TestKlines() {
 	s.mockDo(data, nil)
 	defer s.assertDo()
 
-	symbol := "LTCBTC"
+	pair := "BTCUSDT"
 	interval := "15m"
 	limit := 10
 	startTime := int64(1499040000000)
 	endTime := int64(1499040000001)
 	s.assertReq(func(r *request) {
 		e := newRequest().setParams(params{
-			"symbol":    symbol,
+			"pair":      pair,
 			"interval":  interval,
 			"limit":     limit,
 			"startTime": startTime,
@@ -63,7 +63,7 @@ func (s *indexPriceKlineServiceTestSuite) TestKlines() {
 		})
 		s.assertRequestEqual(e, r)
 	})
-	klines, err := s.client.NewKlinesService().Symbol(symbol).
+	klines, err := s.client.NewIndexPriceKlinesService().Pair(pair).
 		Interval(interval).Limit(limit).StartTime(startTime).
 		EndTime(endTime).Do(newContext())
 	s.r().NoError(err)
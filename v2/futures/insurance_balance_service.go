@@ -0,0 +1,60 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetInsuranceBalanceService get the insurance fund balance snapshots
+type GetInsuranceBalanceService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, to get the insurance fund of the symbol only
+func (s *GetInsuranceBalanceService) Symbol(symbol string) *GetInsuranceBalanceService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetInsuranceBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*InsuranceBalance, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/insuranceBalance",
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*InsuranceBalance{}, err
+	}
+	res = make([]*InsuranceBalance, 0)
+	if s.symbol != nil {
+		// a single insurance fund is returned for a symbol
+		balance := new(InsuranceBalance)
+		err = json.Unmarshal(data, balance)
+		res = append(res, balance)
+	} else {
+		err = json.Unmarshal(data, &res)
+	}
+	if err != nil {
+		return []*InsuranceBalance{}, err
+	}
+	return res, nil
+}
+
+// InsuranceBalance define an insurance fund and the symbols it covers
+type InsuranceBalance struct {
+	Symbols []string                 `json:"symbols"`
+	Assets  []*InsuranceBalanceAsset `json:"assets"`
+}
+
+// InsuranceBalanceAsset define the balance of an asset of an insurance fund
+type InsuranceBalanceAsset struct {
+	Asset         string `json:"asset"`
+	MarginBalance string `json:"marginBalance"`
+	UpdateTime    int64  `json:"updateTime"`
+}
@@ -0,0 +1,81 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type insuranceBalanceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestInsuranceBalanceService(t *testing.T) {
+	suite.Run(t, new(insuranceBalanceServiceTestSuite))
+}
+
+func (s *insuranceBalanceServiceTestSuite) TestGetInsuranceBalance() {
+	data := []byte(`[
+		{
+			"symbols": ["BNBUSDT", "BTCUSDT"],
+			"assets": [
+				{
+					"asset": "USDT",
+					"marginBalance": "793930579.315848",
+					"updateTime": 1719997200000
+				}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetInsuranceBalanceService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*InsuranceBalance{
+		{
+			Symbols: []string{"BNBUSDT", "BTCUSDT"},
+			Assets: []*InsuranceBalanceAsset{
+				{Asset: "USDT", MarginBalance: "793930579.315848", UpdateTime: 1719997200000},
+			},
+		},
+	}, res)
+}
+
+func (s *insuranceBalanceServiceTestSuite) TestGetSymbolInsuranceBalance() {
+	data := []byte(`{
+		"symbols": ["BTCUSDT", "BTCUSDC"],
+		"assets": [
+			{
+				"asset": "USDC",
+				"marginBalance": "299999998.6497832",
+				"updateTime": 1719997200000
+			},
+			{
+				"asset": "USDT",
+				"marginBalance": "793930579.315848",
+				"updateTime": 1719997200000
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", symbol)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetInsuranceBalanceService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal([]string{"BTCUSDT", "BTCUSDC"}, res[0].Symbols)
+	r.Len(res[0].Assets, 2)
+	r.Equal(&InsuranceBalanceAsset{Asset: "USDC", MarginBalance: "299999998.6497832", UpdateTime: 1719997200000}, res[0].Assets[0])
+}
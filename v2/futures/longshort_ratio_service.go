@@ -10,7 +10,7 @@ import (
 type LongShortRatioService struct {
 	c         *Client
 	symbol    string
-	period    string
+	period    PeriodType
 	limit     *int
 	startTime *int64
 	endTime   *int64
@@ -22,9 +22,9 @@ func (s *LongShortRatioService) Symbol(symbol string) *LongShortRatioService {
 	return s
 }
 
-// Period set period interval
+// Period set period interval, one of the PeriodType values
 func (s *LongShortRatioService) Period(period string) *LongShortRatioService {
-	s.period = period
+	s.period = PeriodType(period)
 	return s
 }
 
@@ -48,6 +48,9 @@ func (s *LongShortRatioService) EndTime(endTime int64) *LongShortRatioService {
 
 // Do send request
 func (s *LongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	if err = validEnum("period", s.period); err != nil {
+		return []*LongShortRatio{}, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/futures/data/globalLongShortAccountRatio",
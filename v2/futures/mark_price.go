@@ -169,6 +169,28 @@ type LeverageBracket struct {
 	Brackets []Bracket `json:"brackets"`
 }
 
+// MaxLeverage return the highest initial leverage of the brackets
+func (b *LeverageBracket) MaxLeverage() int {
+	maxLeverage := 0
+	for _, bracket := range b.Brackets {
+		if bracket.InitialLeverage > maxLeverage {
+			maxLeverage = bracket.InitialLeverage
+		}
+	}
+	return maxLeverage
+}
+
+// NotionalCap return the highest notional of a position at leverage, 0 when the leverage is above MaxLeverage
+func (b *LeverageBracket) NotionalCap(leverage int) float64 {
+	notionalCap := 0.0
+	for _, bracket := range b.Brackets {
+		if bracket.InitialLeverage >= leverage && bracket.NotionalCap > notionalCap {
+			notionalCap = bracket.NotionalCap
+		}
+	}
+	return notionalCap
+}
+
 // Bracket define the bracket
 type Bracket struct {
 	Bracket          int     `json:"bracket"`
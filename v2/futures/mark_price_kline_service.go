@@ -6,7 +6,7 @@ import (
 	"net/http"
 )
 
-// MarkPriceKlinesService list mark price klines
+// MarkPriceKlinesService list mark price klines, see IndexPriceKlinesService for the index price klines
 type MarkPriceKlinesService struct {
 	c         *Client
 	symbol    string
@@ -90,3 +90,6 @@ func (mpks *MarkPriceKlinesService) Do(ctx context.Context, opts ...RequestOptio
 	}
 	return res, nil
 }
+
+// GetMarkPriceKlineService list mark price klines, see MarkPriceKlinesService
+type GetMarkPriceKlineService = MarkPriceKlinesService
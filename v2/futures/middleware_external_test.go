@@ -0,0 +1,35 @@
+package futures_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/futures"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUseOutsidePackage check that a middleware can be written outside of the package
+func TestUseOutsidePackage(t *testing.T) {
+	r := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"serverTime": 1499827319559}`))
+	}))
+	defer server.Close()
+
+	c := futures.NewClient("key", "secret")
+	c.BaseURL = server.URL
+	var seen []string
+	c.Use(func(next futures.RequestFunc) futures.RequestFunc {
+		return func(ctx context.Context, req futures.Request) ([]byte, http.Header, error) {
+			seen = append(seen, req.Method()+" "+req.Endpoint())
+			return next(ctx, req)
+		}
+	})
+
+	serverTime, err := c.NewServerTimeService().Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(1499827319559), serverTime)
+	r.Equal([]string{"GET /fapi/v1/time"}, seen)
+}
@@ -0,0 +1,54 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+)
+
+func TestClientUse(t *testing.T) {
+	r := require.New(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"code": -1001, "msg": "Internal error"}`))
+			return
+		}
+		w.Write([]byte(`[{"symbol": "BTCUSDT", "price": "6000.01"}]`))
+	}))
+	defer server.Close()
+
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+	var endpoints []string
+	c.Use(func(next RequestFunc) RequestFunc {
+		return func(ctx context.Context, r Request) ([]byte, http.Header, error) {
+			endpoints = append(endpoints, r.Method()+" "+r.Endpoint())
+			return next(ctx, r)
+		}
+	}, func(next RequestFunc) RequestFunc {
+		// retry once on internal errors
+		return func(ctx context.Context, r Request) ([]byte, http.Header, error) {
+			data, header, err := next(ctx, r)
+			var apiErr *common.APIError
+			if errors.As(err, &apiErr) && apiErr.Code == -1001 {
+				return next(ctx, r)
+			}
+			return data, header, err
+		}
+	})
+
+	res, err := c.NewListPricesService().Symbol("BTCUSDT").Do(context.Background())
+	r.NoError(err)
+	r.Equal([]*SymbolPrice{{Symbol: "BTCUSDT", Price: "6000.01"}}, res)
+	r.Equal(2, requests)
+	r.Equal([]string{"GET /fapi/v1/ticker/price"}, endpoints)
+}
@@ -49,7 +49,7 @@ type OpenInterest struct {
 type OpenInterestStatisticsService struct {
 	c         *Client
 	symbol    string
-	period    string
+	period    PeriodType
 	limit     *int
 	startTime *int64
 	endTime   *int64
@@ -61,9 +61,9 @@ func (s *OpenInterestStatisticsService) Symbol(symbol string) *OpenInterestStati
 	return s
 }
 
-// Period set period interval
+// Period set period interval, one of the PeriodType values
 func (s *OpenInterestStatisticsService) Period(period string) *OpenInterestStatisticsService {
-	s.period = period
+	s.period = PeriodType(period)
 	return s
 }
 
@@ -87,6 +87,9 @@ func (s *OpenInterestStatisticsService) EndTime(endTime int64) *OpenInterestStat
 
 // Do send request
 func (s *OpenInterestStatisticsService) Do(ctx context.Context, opts ...RequestOption) (res []*OpenInterestStatistic, err error) {
+	if err = validEnum("period", s.period); err != nil {
+		return []*OpenInterestStatistic{}, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/futures/data/openInterestHist",
@@ -3,13 +3,20 @@ package futures
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 )
 
-// CreateOrderService create order
+// ErrInvalidParam is returned, wrapped, when an order is built with conflicting parameters,
+// see common.ErrInvalidParam
+var ErrInvalidParam = common.ErrInvalidParam
+
+// CreateOrderService create order.
+// The commission asset of the resulting trades is BNB when the BNB fee discount is enabled, see ChangeFeeBurnService.
 type CreateOrderService struct {
 	c                *Client
 	symbol           string
@@ -28,6 +35,9 @@ type CreateOrderService struct {
 	priceProtect     *bool
 	newOrderRespType NewOrderRespType
 	closePosition    *bool
+	priceMatch       *PriceMatchType
+	stpMode          *STPMode
+	goodTillDate     *int64
 }
 
 // Symbol set symbol
@@ -42,7 +52,8 @@ func (s *CreateOrderService) Side(side SideType) *CreateOrderService {
 	return s
 }
 
-// PositionSide set side
+// PositionSide set positionSide, required in hedge mode (LONG or SHORT), otherwise the order
+// is rejected with -4061, defaults to BOTH in one-way mode
 func (s *CreateOrderService) PositionSide(positionSide PositionSideType) *CreateOrderService {
 	s.positionSide = &positionSide
 	return s
@@ -96,13 +107,15 @@ func (s *CreateOrderService) WorkingType(workingType WorkingType) *CreateOrderSe
 	return s
 }
 
-// ActivationPrice set activationPrice
+// ActivationPrice set activationPrice, used with TRAILING_STOP_MARKET orders,
+// defaults to the latest or mark price (see WorkingType) when the order is placed
 func (s *CreateOrderService) ActivationPrice(activationPrice string) *CreateOrderService {
 	s.activationPrice = &activationPrice
 	return s
 }
 
-// CallbackRate set callbackRate
+// CallbackRate set callbackRate, the trailing percentage required by TRAILING_STOP_MARKET orders:
+// from 0.1 to 5 for BTC contracts and from 0.1 to 10 for the others, 1 meaning 1%
 func (s *CreateOrderService) CallbackRate(callbackRate string) *CreateOrderService {
 	s.callbackRate = &callbackRate
 	return s
@@ -120,14 +133,112 @@ func (s *CreateOrderService) NewOrderResponseType(newOrderResponseType NewOrderR
 	return s
 }
 
-// ClosePosition set closePosition
+// ClosePosition set closePosition, true closes the whole position with a STOP_MARKET or
+// TAKE_PROFIT_MARKET order, quantity must not be set then
 func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderService {
 	s.closePosition = &closePosition
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+// PriceMatch set priceMatch, the price of the LIMIT, STOP or TAKE_PROFIT order then follows
+// the opponent or the queue side of the order book, price must not be set then
+func (s *CreateOrderService) PriceMatch(priceMatch PriceMatchType) *CreateOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// SetPriceMatch set priceMatch, see PriceMatch
+func (s *CreateOrderService) SetPriceMatch(priceMatch PriceMatchType) *CreateOrderService {
+	return s.PriceMatch(priceMatch)
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode, the account default mode is used when not set
+func (s *CreateOrderService) SelfTradePreventionMode(stpMode STPMode) *CreateOrderService {
+	s.stpMode = &stpMode
+	return s
+}
 
+// SetSTPMode set selfTradePreventionMode, see SelfTradePreventionMode
+func (s *CreateOrderService) SetSTPMode(stpMode STPMode) *CreateOrderService {
+	return s.SelfTradePreventionMode(stpMode)
+}
+
+// GoodTillDate set goodTillDate in ms, the automatic cancel time of a TimeInForceTypeGTD order,
+// it is required by GTD orders and must be at least 600 seconds in the future
+func (s *CreateOrderService) GoodTillDate(goodTillDate int64) *CreateOrderService {
+	s.goodTillDate = &goodTillDate
+	return s
+}
+
+// minGoodTillDelay is the shortest delay before the goodTillDate of a GTD order, in ms
+const minGoodTillDelay = int64(600 * time.Second / time.Millisecond)
+
+func (s *CreateOrderService) validate() error {
+	if s.closePosition != nil && *s.closePosition && s.quantity != "" {
+		return fmt.Errorf("%w: quantity cannot be sent with closePosition", ErrInvalidParam)
+	}
+	if s.priceMatch != nil && s.price != nil {
+		return fmt.Errorf("%w: price cannot be sent with priceMatch", ErrInvalidParam)
+	}
+	if s.side != "" {
+		if err := validEnum("side", s.side); err != nil {
+			return err
+		}
+	}
+	if s.positionSide != nil {
+		if err := validEnum("positionSide", *s.positionSide); err != nil {
+			return err
+		}
+	}
+	if s.orderType != "" {
+		if err := validEnum("type", s.orderType); err != nil {
+			return err
+		}
+	}
+	if s.timeInForce != nil {
+		if err := validEnum("timeInForce", *s.timeInForce); err != nil {
+			return err
+		}
+	}
+	if s.priceMatch != nil {
+		if err := validEnum("priceMatch", *s.priceMatch); err != nil {
+			return err
+		}
+	}
+	if s.workingType != nil {
+		if err := validEnum("workingType", *s.workingType); err != nil {
+			return err
+		}
+	}
+	if s.stpMode != nil {
+		if err := validEnum("selfTradePreventionMode", *s.stpMode); err != nil {
+			return err
+		}
+	}
+	if s.newOrderRespType != "" {
+		if err := validEnum("newOrderRespType", s.newOrderRespType); err != nil {
+			return err
+		}
+	}
+	gtd := s.timeInForce != nil && *s.timeInForce == TimeInForceTypeGTD
+	if gtd && s.goodTillDate == nil {
+		return fmt.Errorf("%w: goodTillDate is required by timeInForce GTD", ErrInvalidParam)
+	}
+	if s.goodTillDate != nil {
+		if !gtd {
+			return fmt.Errorf("%w: goodTillDate can only be sent with timeInForce GTD", ErrInvalidParam)
+		}
+		if *s.goodTillDate < time.Now().UnixNano()/int64(time.Millisecond)+minGoodTillDelay {
+			return fmt.Errorf("%w: goodTillDate must be at least 600 seconds in the future", ErrInvalidParam)
+		}
+	}
+	return nil
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	if err = s.validate(); err != nil {
+		return []byte{}, &http.Header{}, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -175,6 +286,15 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	if s.stpMode != nil {
+		m["selfTradePreventionMode"] = *s.stpMode
+	}
+	if s.goodTillDate != nil {
+		m["goodTillDate"] = *s.goodTillDate
+	}
 	r.setFormParams(m)
 	data, header, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -185,6 +305,12 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 
 // Do send request
 func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	if s.c.dryRun {
+		if err = s.Validate(s.c.dryRunInfo, ""); err != nil {
+			return nil, err
+		}
+		return s.dryRunResponse(), nil
+	}
 	data, header, err := s.createOrder(ctx, "/fapi/v1/order", opts...)
 	if err != nil {
 		return nil, err
@@ -200,34 +326,43 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
-// CreateOrderResponse define create order response
+// CreateOrderResponse define create order response, the execution fields like AvgPrice,
+// CumQuantity and CumQuote are only filled with NewOrderRespTypeRESULT
 type CreateOrderResponse struct {
-	Symbol            string           `json:"symbol"`
-	OrderID           int64            `json:"orderId"`
-	ClientOrderID     string           `json:"clientOrderId"`
-	Price             string           `json:"price"`
-	OrigQuantity      string           `json:"origQty"`
-	ExecutedQuantity  string           `json:"executedQty"`
-	CumQuote          string           `json:"cumQuote"`
-	ReduceOnly        bool             `json:"reduceOnly"`
-	Status            OrderStatusType  `json:"status"`
-	StopPrice         string           `json:"stopPrice"`
-	TimeInForce       TimeInForceType  `json:"timeInForce"`
-	Type              OrderType        `json:"type"`
-	Side              SideType         `json:"side"`
-	UpdateTime        int64            `json:"updateTime"`
-	WorkingType       WorkingType      `json:"workingType"`
-	ActivatePrice     string           `json:"activatePrice"`
-	PriceRate         string           `json:"priceRate"`
-	AvgPrice          string           `json:"avgPrice"`
-	PositionSide      PositionSideType `json:"positionSide"`
-	ClosePosition     bool             `json:"closePosition"`
-	PriceProtect      bool             `json:"priceProtect"`
-	RateLimitOrder10s string           `json:"rateLimitOrder10s,omitempty"`
-	RateLimitOrder1m  string           `json:"rateLimitOrder1m,omitempty"`
-}
-
-// ListOpenOrdersService list opened orders
+	// DryRun is true for the synthetic responses of the client dry run mode, see WithDryRun
+	DryRun bool `json:"-"`
+
+	Symbol                  string           `json:"symbol"`
+	OrderID                 int64            `json:"orderId"`
+	ClientOrderID           string           `json:"clientOrderId"`
+	Price                   string           `json:"price"`
+	OrigQuantity            string           `json:"origQty"`
+	ExecutedQuantity        string           `json:"executedQty"`
+	CumQuantity             string           `json:"cumQty"`
+	CumQuote                string           `json:"cumQuote"`
+	ReduceOnly              bool             `json:"reduceOnly"`
+	Status                  OrderStatusType  `json:"status"`
+	StopPrice               string           `json:"stopPrice"`
+	TimeInForce             TimeInForceType  `json:"timeInForce"`
+	Type                    OrderType        `json:"type"`
+	Side                    SideType         `json:"side"`
+	UpdateTime              int64            `json:"updateTime"`
+	WorkingType             WorkingType      `json:"workingType"`
+	ActivatePrice           string           `json:"activatePrice"`
+	PriceRate               string           `json:"priceRate"`
+	AvgPrice                string           `json:"avgPrice"`
+	PositionSide            PositionSideType `json:"positionSide"`
+	ClosePosition           bool             `json:"closePosition"`
+	PriceProtect            bool             `json:"priceProtect"`
+	PriceMatch              PriceMatchType   `json:"priceMatch"`
+	SelfTradePreventionMode STPMode          `json:"selfTradePreventionMode"`
+	GoodTillDate            int64            `json:"goodTillDate"`
+	RateLimitOrder10s       string           `json:"rateLimitOrder10s,omitempty"`
+	RateLimitOrder1m        string           `json:"rateLimitOrder1m,omitempty"`
+}
+
+// ListOpenOrdersService list opened orders, of all the symbols when no symbol is set.
+// The endpoint has no pagination, all the open orders are returned at once.
 type ListOpenOrdersService struct {
 	c      *Client
 	symbol string
@@ -261,7 +396,12 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	return res, nil
 }
 
-// GetOpenOrderService query current open order
+// ErrCodeNoSuchOrder is the API error code of an unknown order, GetOpenOrderService returns it
+// for the orders which are not open anymore too, see common.IsAPIErrorCode
+const ErrCodeNoSuchOrder int64 = -2013
+
+// GetOpenOrderService query current open order, a cheap check that an order is still open:
+// an API error of code ErrCodeNoSuchOrder is returned once it is filled, canceled or expired
 type GetOpenOrderService struct {
 	c                 *Client
 	symbol            string
@@ -269,21 +409,25 @@ type GetOpenOrderService struct {
 	origClientOrderID *string
 }
 
+// Symbol set symbol
 func (s *GetOpenOrderService) Symbol(symbol string) *GetOpenOrderService {
 	s.symbol = symbol
 	return s
 }
 
+// OrderID set orderID
 func (s *GetOpenOrderService) OrderID(orderID int64) *GetOpenOrderService {
 	s.orderID = &orderID
 	return s
 }
 
+// OrigClientOrderID set origClientOrderID
 func (s *GetOpenOrderService) OrigClientOrderID(origClientOrderID string) *GetOpenOrderService {
 	s.origClientOrderID = &origClientOrderID
 	return s
 }
 
+// Do send request
 func (s *GetOpenOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
 	r := &request{
 		method:   http.MethodGet,
@@ -292,7 +436,7 @@ func (s *GetOpenOrderService) Do(ctx context.Context, opts ...RequestOption) (re
 	}
 	r.setParam("symbol", s.symbol)
 	if s.orderID == nil && s.origClientOrderID == nil {
-		return nil, errors.New("either orderId or origClientOrderId must be sent")
+		return nil, fmt.Errorf("%w: either orderId or origClientOrderId must be sent", ErrInvalidParam)
 	}
 	if s.orderID != nil {
 		r.setParam("orderId", *s.orderID)
@@ -366,31 +510,37 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 
 // Order define order info
 type Order struct {
-	Symbol           string           `json:"symbol"`
-	OrderID          int64            `json:"orderId"`
-	ClientOrderID    string           `json:"clientOrderId"`
-	Price            string           `json:"price"`
-	ReduceOnly       bool             `json:"reduceOnly"`
-	OrigQuantity     string           `json:"origQty"`
-	ExecutedQuantity string           `json:"executedQty"`
-	CumQuantity      string           `json:"cumQty"`
-	CumQuote         string           `json:"cumQuote"`
-	Status           OrderStatusType  `json:"status"`
-	TimeInForce      TimeInForceType  `json:"timeInForce"`
-	Type             OrderType        `json:"type"`
-	Side             SideType         `json:"side"`
-	StopPrice        string           `json:"stopPrice"`
-	Time             int64            `json:"time"`
-	UpdateTime       int64            `json:"updateTime"`
-	WorkingType      WorkingType      `json:"workingType"`
-	ActivatePrice    string           `json:"activatePrice"`
-	PriceRate        string           `json:"priceRate"`
-	AvgPrice         string           `json:"avgPrice"`
-	OrigType         string           `json:"origType"`
-	PositionSide     PositionSideType `json:"positionSide"`
-	PriceProtect     bool             `json:"priceProtect"`
-	ClosePosition    bool             `json:"closePosition"`
-}
+	Symbol                  string           `json:"symbol"`
+	OrderID                 int64            `json:"orderId"`
+	ClientOrderID           string           `json:"clientOrderId"`
+	Price                   string           `json:"price"`
+	ReduceOnly              bool             `json:"reduceOnly"`
+	OrigQuantity            string           `json:"origQty"`
+	ExecutedQuantity        string           `json:"executedQty"`
+	CumQuantity             string           `json:"cumQty"`
+	CumQuote                string           `json:"cumQuote"`
+	Status                  OrderStatusType  `json:"status"`
+	TimeInForce             TimeInForceType  `json:"timeInForce"`
+	Type                    OrderType        `json:"type"`
+	Side                    SideType         `json:"side"`
+	StopPrice               string           `json:"stopPrice"`
+	Time                    int64            `json:"time"`
+	UpdateTime              int64            `json:"updateTime"`
+	WorkingType             WorkingType      `json:"workingType"`
+	ActivatePrice           string           `json:"activatePrice"`
+	PriceRate               string           `json:"priceRate"`
+	AvgPrice                string           `json:"avgPrice"`
+	OrigType                string           `json:"origType"`
+	PositionSide            PositionSideType `json:"positionSide"`
+	PriceProtect            bool             `json:"priceProtect"`
+	ClosePosition           bool             `json:"closePosition"`
+	PriceMatch              PriceMatchType   `json:"priceMatch"`
+	SelfTradePreventionMode STPMode          `json:"selfTradePreventionMode"`
+	GoodTillDate            int64            `json:"goodTillDate"`
+}
+
+// GetFuturesAllOrdersService all account orders, see ListOrdersService
+type GetFuturesAllOrdersService = ListOrdersService
 
 // ListOrdersService all account orders; active, canceled, or filled
 type ListOrdersService struct {
@@ -400,6 +550,9 @@ type ListOrdersService struct {
 	startTime *int64
 	endTime   *int64
 	limit     *int
+
+	// the orders of the time the next page starts at which were already returned
+	pageIDs map[int64]bool
 }
 
 // Symbol set symbol
@@ -408,7 +561,7 @@ func (s *ListOrdersService) Symbol(symbol string) *ListOrdersService {
 	return s
 }
 
-// OrderID set orderID
+// OrderID set orderID, the orders from this one are returned
 func (s *ListOrdersService) OrderID(orderID int64) *ListOrdersService {
 	s.orderID = &orderID
 	return s
@@ -420,13 +573,13 @@ func (s *ListOrdersService) StartTime(startTime int64) *ListOrdersService {
 	return s
 }
 
-// EndTime set endtime
+// EndTime set endtime, without orderID it must be at most 7 days after startTime
 func (s *ListOrdersService) EndTime(endTime int64) *ListOrdersService {
 	s.endTime = &endTime
 	return s
 }
 
-// Limit set limit
+// Limit set limit, default 500 and max 1000
 func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 	s.limit = &limit
 	return s
@@ -434,6 +587,9 @@ func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 
 // Do send request
 func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	if err = s.validate(); err != nil {
+		return []*Order{}, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/allOrders",
@@ -464,6 +620,56 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+func (s *ListOrdersService) validate() error {
+	if s.startTime != nil && s.endTime != nil {
+		if *s.endTime < *s.startTime {
+			return fmt.Errorf("%w: endTime is before startTime", ErrInvalidParam)
+		}
+		if s.orderID == nil && *s.endTime-*s.startTime > archiveWindow {
+			return fmt.Errorf("%w: startTime and endTime cannot be more than 7 days apart without orderId", ErrInvalidParam)
+		}
+	}
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		return fmt.Errorf("%w: limit %d is out of [1, 1000]", ErrInvalidParam, *s.limit)
+	}
+	return nil
+}
+
+// NextPage set startTime for the request of the next page after orders, the result of the last request.
+// It return the orders which were not returned by the previous page, as the orders sharing the time of
+// the last order are requested again, and false when there are no more orders in the time window.
+// When a whole page shares a single millisecond the next page starts after it,
+// the orders of that millisecond beyond the limit cannot be listed by time.
+func (s *ListOrdersService) NextPage(orders []*Order) (page []*Order, more bool) {
+	page = make([]*Order, 0, len(orders))
+	for _, o := range orders {
+		if !s.pageIDs[o.OrderID] {
+			page = append(page, o)
+		}
+	}
+	limit := 500
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	if len(orders) == 0 || len(orders) < limit {
+		return page, false
+	}
+	startTime := orders[len(orders)-1].Time
+	s.pageIDs = make(map[int64]bool)
+	if orders[0].Time == startTime {
+		startTime++
+	} else {
+		for _, o := range orders {
+			if o.Time == startTime {
+				s.pageIDs[o.OrderID] = true
+			}
+		}
+	}
+	s.orderID = nil
+	s.startTime = &startTime
+	return page, true
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
@@ -492,6 +698,9 @@ func (s *CancelOrderService) OrigClientOrderID(origClientOrderID string) *Cancel
 
 // Do send request
 func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	if s.c.dryRun {
+		return s.dryRunResponse()
+	}
 	r := &request{
 		method:   http.MethodDelete,
 		endpoint: "/fapi/v1/order",
@@ -518,27 +727,33 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 
 // CancelOrderResponse define response of canceling order
 type CancelOrderResponse struct {
-	ClientOrderID    string           `json:"clientOrderId"`
-	CumQuantity      string           `json:"cumQty"`
-	CumQuote         string           `json:"cumQuote"`
-	ExecutedQuantity string           `json:"executedQty"`
-	OrderID          int64            `json:"orderId"`
-	OrigQuantity     string           `json:"origQty"`
-	Price            string           `json:"price"`
-	ReduceOnly       bool             `json:"reduceOnly"`
-	Side             SideType         `json:"side"`
-	Status           OrderStatusType  `json:"status"`
-	StopPrice        string           `json:"stopPrice"`
-	Symbol           string           `json:"symbol"`
-	TimeInForce      TimeInForceType  `json:"timeInForce"`
-	Type             OrderType        `json:"type"`
-	UpdateTime       int64            `json:"updateTime"`
-	WorkingType      WorkingType      `json:"workingType"`
-	ActivatePrice    string           `json:"activatePrice"`
-	PriceRate        string           `json:"priceRate"`
-	OrigType         string           `json:"origType"`
-	PositionSide     PositionSideType `json:"positionSide"`
-	PriceProtect     bool             `json:"priceProtect"`
+	// DryRun is true for the synthetic responses of the client dry run mode, see WithDryRun
+	DryRun bool `json:"-"`
+
+	ClientOrderID           string           `json:"clientOrderId"`
+	CumQuantity             string           `json:"cumQty"`
+	CumQuote                string           `json:"cumQuote"`
+	ExecutedQuantity        string           `json:"executedQty"`
+	OrderID                 int64            `json:"orderId"`
+	OrigQuantity            string           `json:"origQty"`
+	Price                   string           `json:"price"`
+	ReduceOnly              bool             `json:"reduceOnly"`
+	Side                    SideType         `json:"side"`
+	Status                  OrderStatusType  `json:"status"`
+	StopPrice               string           `json:"stopPrice"`
+	Symbol                  string           `json:"symbol"`
+	TimeInForce             TimeInForceType  `json:"timeInForce"`
+	Type                    OrderType        `json:"type"`
+	UpdateTime              int64            `json:"updateTime"`
+	WorkingType             WorkingType      `json:"workingType"`
+	ActivatePrice           string           `json:"activatePrice"`
+	PriceRate               string           `json:"priceRate"`
+	OrigType                string           `json:"origType"`
+	PositionSide            PositionSideType `json:"positionSide"`
+	PriceProtect            bool             `json:"priceProtect"`
+	PriceMatch              PriceMatchType   `json:"priceMatch"`
+	SelfTradePreventionMode STPMode          `json:"selfTradePreventionMode"`
+	GoodTillDate            int64            `json:"goodTillDate"`
 }
 
 // CancelAllOpenOrdersService cancel all open orders
@@ -555,17 +770,52 @@ func (s *CancelAllOpenOrdersService) Symbol(symbol string) *CancelAllOpenOrdersS
 
 // Do send request
 func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	_, err = s.c.NewCancelAllFuturesOpenOrdersService().Symbol(s.symbol).Do(ctx, opts...)
+	return err
+}
+
+// CancelAllFuturesOpenOrdersService cancel all open orders of a symbol, returning the confirmation
+type CancelAllFuturesOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CancelAllFuturesOpenOrdersService) Symbol(symbol string) *CancelAllFuturesOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *CancelAllFuturesOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CancelAllOpenOrdersResponse, err error) {
+	if s.c.dryRun {
+		return s.dryRunResponse()
+	}
 	r := &request{
 		method:   http.MethodDelete,
 		endpoint: "/fapi/v1/allOpenOrders",
 		secType:  secTypeSigned,
 	}
 	r.setFormParam("symbol", s.symbol)
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	res = new(CancelAllOpenOrdersResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelAllOpenOrdersResponse define the confirmation of canceling all open orders
+type CancelAllOpenOrdersResponse struct {
+	// DryRun is true for the synthetic responses of the client dry run mode, see WithDryRun
+	DryRun bool `json:"-"`
+
+	Code json.Number `json:"code"` // sent either as a number or a string
+	Msg  string      `json:"msg"`
 }
 
 // CancelMultiplesOrdersService cancel a list of orders
@@ -596,6 +846,9 @@ func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDLi
 
 // Do send request
 func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*CancelOrderResponse, err error) {
+	if s.c.dryRun {
+		return s.dryRunResponse()
+	}
 	r := &request{
 		method:   http.MethodDelete,
 		endpoint: "/fapi/v1/batchOrders",
@@ -622,7 +875,8 @@ func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOp
 	return res, nil
 }
 
-// ListLiquidationOrdersService list liquidation orders
+// ListLiquidationOrdersService list the recent liquidation orders of the whole market, no API key is required,
+// see ListUserLiquidationOrdersService for the liquidation orders of the account
 type ListLiquidationOrdersService struct {
 	c         *Client
 	symbol    *string
@@ -686,13 +940,16 @@ func (s *ListLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOp
 	return res, nil
 }
 
+// GetMarketLiquidationOrdersService list the recent liquidation orders of the whole market, see ListLiquidationOrdersService
+type GetMarketLiquidationOrdersService = ListLiquidationOrdersService
+
 // LiquidationOrder define liquidation order
 type LiquidationOrder struct {
 	Symbol           string          `json:"symbol"`
 	Price            string          `json:"price"`
 	OrigQuantity     string          `json:"origQty"`
 	ExecutedQuantity string          `json:"executedQty"`
-	AveragePrice     string          `json:"avragePrice"`
+	AveragePrice     string          `json:"averagePrice"`
 	Status           OrderStatusType `json:"status"`
 	TimeInForce      TimeInForceType `json:"timeInForce"`
 	Type             OrderType       `json:"type"`
@@ -818,8 +1075,37 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 		secType:  secTypeSigned,
 	}
 
+	if s.c.dryRun {
+		res = new(CreateBatchOrdersResponse)
+		for _, order := range s.orders {
+			if err = order.Validate(s.c.dryRunInfo, ""); err != nil {
+				return &CreateBatchOrdersResponse{}, err
+			}
+			o := order.dryRunResponse()
+			res.Orders = append(res.Orders, &Order{
+				Symbol:        o.Symbol,
+				OrderID:       o.OrderID,
+				ClientOrderID: o.ClientOrderID,
+				Price:         o.Price,
+				OrigQuantity:  o.OrigQuantity,
+				Status:        o.Status,
+				TimeInForce:   o.TimeInForce,
+				Type:          o.Type,
+				Side:          o.Side,
+				StopPrice:     o.StopPrice,
+				PositionSide:  o.PositionSide,
+				ReduceOnly:    o.ReduceOnly,
+				ClosePosition: o.ClosePosition,
+			})
+		}
+		return res, nil
+	}
+
 	orders := []params{}
 	for _, order := range s.orders {
+		if err = order.validate(); err != nil {
+			return &CreateBatchOrdersResponse{}, err
+		}
 		m := params{
 			"symbol":           order.symbol,
 			"side":             order.side,
@@ -861,6 +1147,15 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 		if order.closePosition != nil {
 			m["closePosition"] = *order.closePosition
 		}
+		if order.priceMatch != nil {
+			m["priceMatch"] = *order.priceMatch
+		}
+		if order.stpMode != nil {
+			m["selfTradePreventionMode"] = *order.stpMode
+		}
+		if order.goodTillDate != nil {
+			m["goodTillDate"] = *order.goodTillDate
+		}
 		orders = append(orders, m)
 	}
 	b, err := json.Marshal(orders)
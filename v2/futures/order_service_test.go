@@ -1,8 +1,10 @@
 package futures
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -113,6 +115,277 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.assertCreateOrderResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCreateTrailingStopMarketOrder() {
+	data := []byte(`{
+		"clientOrderId": "trailing",
+		"cumQuote": "0",
+		"executedQty": "0",
+		"orderId": 22542180,
+		"origQty": "0.01",
+		"price": "0",
+		"reduceOnly": true,
+		"side": "SELL",
+		"status": "NEW",
+		"stopPrice": "0",
+		"symbol": "BTCUSDT",
+		"timeInForce": "GTC",
+		"type": "TRAILING_STOP_MARKET",
+		"updateTime": 1566818724722,
+		"workingType": "MARK_PRICE",
+		"activatePrice": "65000",
+		"priceRate": "0.5",
+		"positionSide": "BOTH",
+		"closePosition": false,
+		"priceProtect": false
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeSell,
+			"type":             OrderTypeTrailingStopMarket,
+			"quantity":         "0.01",
+			"reduceOnly":       true,
+			"workingType":      WorkingTypeMarkPrice,
+			"activationPrice":  "65000",
+			"callbackRate":     "0.5",
+			"newOrderRespType": NewOrderRespTypeRESULT,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeTrailingStopMarket).Quantity("0.01").ReduceOnly(true).
+		WorkingType(WorkingTypeMarkPrice).ActivationPrice("65000").CallbackRate("0.5").
+		NewOrderResponseType(NewOrderRespTypeRESULT).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(OrderTypeTrailingStopMarket, res.Type)
+	r.Equal("65000", res.ActivatePrice)
+	r.Equal("0.5", res.PriceRate)
+}
+
+func (s *orderServiceTestSuite) TestCreateClosePositionOrder() {
+	data := []byte(`{"orderId": 22542181, "type": "STOP_MARKET", "closePosition": true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeSell,
+			"type":             OrderTypeStopMarket,
+			"stopPrice":        "9000",
+			"reduceOnly":       true,
+			"closePosition":    true,
+			"newOrderRespType": NewOrderRespTypeRESULT,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeStopMarket).StopPrice("9000").ReduceOnly(true).ClosePosition(true).
+		NewOrderResponseType(NewOrderRespTypeRESULT).Do(newContext())
+	s.r().NoError(err)
+	s.r().True(res.ClosePosition)
+}
+
+func (s *orderServiceTestSuite) TestCreateClosePositionOrderWithQuantity() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeStopMarket).StopPrice("9000").Quantity("1").ClosePosition(true).
+		Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
+
+func (s *orderServiceTestSuite) TestCreatePriceMatchOrder() {
+	data := []byte(`{"orderId": 22542182, "type": "LIMIT", "price": "10000", "priceMatch": "QUEUE_5"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTC,
+			"quantity":         "1",
+			"priceMatch":       PriceMatchTypeQueue5,
+			"newOrderRespType": NewOrderRespTypeRESULT,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").PriceMatch(PriceMatchTypeQueue5).
+		NewOrderResponseType(NewOrderRespTypeRESULT).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(PriceMatchTypeQueue5, res.PriceMatch)
+}
+
+func (s *orderServiceTestSuite) TestCreatePriceMatchOrderWithPrice() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("10000").
+		SetPriceMatch(PriceMatchTypeOpponent).Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderGoodTillDate() {
+	data := []byte(`{"orderId": 22542184, "type": "LIMIT", "timeInForce": "GTD", "goodTillDate": 4102444800000}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTD,
+			"quantity":         "1",
+			"price":            "10000",
+			"goodTillDate":     int64(4102444800000),
+			"newOrderRespType": NewOrderRespTypeACK,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTD).Quantity("1").Price("10000").
+		GoodTillDate(4102444800000).NewOrderResponseType(NewOrderRespTypeACK).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(4102444800000), res.GoodTillDate)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderWithSTPMode() {
+	data := []byte(`{"orderId": 22542183, "type": "LIMIT", "selfTradePreventionMode": "EXPIRE_BOTH"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeBuy,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTC,
+			"quantity":                "1",
+			"price":                   "10000",
+			"selfTradePreventionMode": STPModeExpireBoth,
+			"newOrderRespType":        NewOrderRespTypeRESULT,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("10000").
+		SelfTradePreventionMode(STPModeExpireBoth).NewOrderResponseType(NewOrderRespTypeRESULT).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(STPModeExpireBoth, res.SelfTradePreventionMode)
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").SetSTPMode("EXPIRE_ALL").Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderResult() {
+	data := []byte(`{
+		"clientOrderId": "testOrder",
+		"cumQty": "10",
+		"cumQuote": "100000",
+		"executedQty": "10",
+		"orderId": 22542184,
+		"avgPrice": "10000.0",
+		"origQty": "10",
+		"price": "0",
+		"side": "BUY",
+		"status": "FILLED",
+		"symbol": "BTCUSDT",
+		"type": "MARKET",
+		"updateTime": 1566818724722
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("10").NewOrderResponseType(NewOrderRespTypeRESULT).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(OrderStatusTypeFilled, res.Status)
+	r.Equal("10000.0", res.AvgPrice)
+	r.Equal("10", res.CumQuantity)
+	r.Equal("100000", res.CumQuote)
+}
+
+func (s *orderServiceTestSuite) TestCreateHedgeModeOrder() {
+	data := []byte(`{
+		"orderId": 22542180,
+		"symbol": "BTCUSDT",
+		"side": "SELL",
+		"positionSide": "SHORT",
+		"type": "MARKET",
+		"status": "NEW"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeSell,
+			"positionSide":     PositionSideTypeShort,
+			"type":             OrderTypeMarket,
+			"quantity":         "1",
+			"newOrderRespType": NewOrderRespTypeRESULT,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		PositionSide(PositionSideTypeShort).Type(OrderTypeMarket).Quantity("1").
+		NewOrderResponseType(NewOrderRespTypeRESULT).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(PositionSideTypeShort, res.PositionSide)
+}
+
+func (s *orderServiceTestSuite) TestCreateStopOrders() {
+	tests := []struct {
+		orderType OrderType
+		price     string
+		body      params
+	}{
+		{
+			orderType: OrderTypeStop,
+			price:     "9000",
+			body:      params{"type": OrderTypeStop, "price": "9000", "stopPrice": "9100", "timeInForce": TimeInForceTypeGTC},
+		},
+		{
+			orderType: OrderTypeStopMarket,
+			body:      params{"type": OrderTypeStopMarket, "stopPrice": "9100"},
+		},
+		{
+			orderType: OrderTypeTakeProfit,
+			price:     "11000",
+			body:      params{"type": OrderTypeTakeProfit, "price": "11000", "stopPrice": "10900", "timeInForce": TimeInForceTypeGTC},
+		},
+		{
+			orderType: OrderTypeTakeProfitMarket,
+			body:      params{"type": OrderTypeTakeProfitMarket, "stopPrice": "10900"},
+		},
+	}
+	for _, test := range tests {
+		s.Run(string(test.orderType), func() {
+			s.SetupTest()
+			s.mockDo([]byte(`{"orderId": 1, "type": "`+string(test.orderType)+`"}`), nil)
+			defer s.assertDo()
+			s.assertReq(func(r *request) {
+				e := newSignedRequest().setFormParams(params{
+					"symbol":           "BTCUSDT",
+					"side":             SideTypeSell,
+					"quantity":         "1",
+					"newOrderRespType": NewOrderRespTypeRESULT,
+				}).setFormParams(test.body)
+				s.assertRequestEqual(e, r)
+			})
+			service := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+				Type(test.orderType).Quantity("1").NewOrderResponseType(NewOrderRespTypeRESULT).
+				StopPrice(test.body["stopPrice"].(string))
+			if test.price != "" {
+				service.Price(test.price).TimeInForce(TimeInForceTypeGTC)
+			}
+			res, err := service.Do(newContext())
+			s.r().NoError(err)
+			s.r().Equal(test.orderType, res.Type)
+		})
+	}
+}
+
 func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderResponse) {
 	r := s.r()
 	r.Equal(e.ClientOrderID, a.ClientOrderID, "ClientOrderID")
@@ -226,6 +499,9 @@ func (s *baseOrderTestSuite) assertOrderEqual(e, a *Order) {
 	r.Equal(e.PriceRate, a.PriceRate, "PriceRate")
 	r.Equal(e.PositionSide, a.PositionSide, "PositionSide")
 	r.Equal(e.PriceProtect, a.PriceProtect, "PriceProtect")
+	r.Equal(e.PriceMatch, a.PriceMatch, "PriceMatch")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.GoodTillDate, a.GoodTillDate, "GoodTillDate")
 }
 
 func (s *orderServiceTestSuite) TestGetOpenOrder() {
@@ -249,7 +525,10 @@ func (s *orderServiceTestSuite) TestGetOpenOrder() {
 		  "activatePrice": "10000",
 		  "priceRate":"0.1",
 		  "positionSide":"BOTH",
-		  "priceProtect": false
+		  "priceProtect": false,
+		  "priceMatch": "OPPONENT",
+		  "selfTradePreventionMode": "EXPIRE_TAKER",
+		  "goodTillDate": 1693207680000
 	}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
@@ -289,10 +568,28 @@ func (s *orderServiceTestSuite) TestGetOpenOrder() {
 		ActivatePrice: "10000",
 		PriceRate:     "0.1",
 		PositionSide:  "BOTH",
+		PriceMatch:    PriceMatchTypeOpponent,
+		GoodTillDate:  1693207680000,
+
+		SelfTradePreventionMode: STPModeExpireTaker,
 	}
 	s.assertOrderEqual(e, order)
 }
 
+func (s *orderServiceTestSuite) TestGetOpenOrderNotOpen() {
+	data := []byte(`{"code": -2013, "msg": "Order does not exist."}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	_, err := s.client.NewGetOpenOrderService().Symbol("BTCUSDT").OrderID(1).Do(newContext())
+	s.r().True(common.IsAPIErrorCode(err, ErrCodeNoSuchOrder))
+}
+
+func (s *orderServiceTestSuite) TestGetOpenOrderWithoutID() {
+	_, err := s.client.NewGetOpenOrderService().Symbol("BTCUSDT").Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
+
 func (s *orderServiceTestSuite) TestGetOrder() {
 	data := []byte(`{
 		"symbol": "BTCUSDT",
@@ -433,6 +730,65 @@ func (s *orderServiceTestSuite) TestListOrders() {
 	s.assertOrderEqual(e, orders[0])
 }
 
+func (s *orderServiceTestSuite) TestListOrdersInvalidTimeRange() {
+	r := s.r()
+	day := int64(24 * 3600 * 1000)
+	_, err := s.client.NewListOrdersService().Symbol("BTCUSDT").StartTime(0).EndTime(8 * day).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewListOrdersService().Symbol("BTCUSDT").StartTime(day).EndTime(0).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewListOrdersService().Symbol("BTCUSDT").Limit(1001).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+}
+
+func (s *orderServiceTestSuite) TestListOrdersNextPage() {
+	r := s.r()
+	service := s.client.NewListOrdersService().Symbol("BTCUSDT").OrderID(1).StartTime(100).Limit(3)
+	orders := []*Order{{OrderID: 1, Time: 100}, {OrderID: 2, Time: 150}, {OrderID: 3, Time: 150}}
+	page, more := service.NextPage(orders)
+	r.True(more)
+	r.Equal(orders, page)
+	r.Nil(service.orderID)
+	r.Equal(int64(150), *service.startTime)
+	// the time is copied, not referenced
+	orders[2].Time = 160
+	r.Equal(int64(150), *service.startTime)
+
+	// the orders of 150 are returned again and deduped
+	page, more = service.NextPage([]*Order{{OrderID: 2, Time: 150}, {OrderID: 3, Time: 150}, {OrderID: 4, Time: 200}})
+	r.True(more)
+	r.Equal([]*Order{{OrderID: 4, Time: 200}}, page)
+	r.Equal(int64(200), *service.startTime)
+
+	page, more = service.NextPage([]*Order{{OrderID: 4, Time: 200}, {OrderID: 5, Time: 250}})
+	r.False(more)
+	r.Equal([]*Order{{OrderID: 5, Time: 250}}, page)
+	r.Equal(int64(200), *service.startTime)
+
+	page, more = s.client.NewListOrdersService().NextPage([]*Order{})
+	r.False(more)
+	r.Empty(page)
+}
+
+func (s *orderServiceTestSuite) TestListOrdersNextPageSameMillisecond() {
+	r := s.r()
+	service := s.client.NewListOrdersService().Symbol("BTCUSDT").StartTime(100).Limit(2)
+	page, more := service.NextPage([]*Order{{OrderID: 1, Time: 100}, {OrderID: 2, Time: 150}})
+	r.True(more)
+	r.Len(page, 2)
+	r.Equal(int64(150), *service.startTime)
+
+	// more orders than the limit share 150, requesting from 150 again would return the same page forever
+	page, more = service.NextPage([]*Order{{OrderID: 2, Time: 150}, {OrderID: 3, Time: 150}})
+	r.True(more)
+	r.Equal([]*Order{{OrderID: 3, Time: 150}}, page)
+	r.Equal(int64(151), *service.startTime)
+
+	page, more = service.NextPage([]*Order{{OrderID: 4, Time: 151}})
+	r.False(more)
+	r.Equal([]*Order{{OrderID: 4, Time: 151}}, page)
+}
+
 func (s *orderServiceTestSuite) TestCancelOrder() {
 	data := []byte(`{
 		"clientOrderId": "myOrder1",
@@ -546,6 +902,32 @@ func (s *orderServiceTestSuite) TestCancelAllOpenOrders() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCancelAllFuturesOpenOrders() {
+	data := []byte(`{
+		"code": 200,
+		"msg": "The operation of cancel all open order is done."
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol": symbol,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelAllFuturesOpenOrdersService().Symbol(symbol).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CancelAllOpenOrdersResponse{
+		Code: "200",
+		Msg:  "The operation of cancel all open order is done.",
+	}, res)
+}
+
 func (s *orderServiceTestSuite) TestListLiquidationOrders() {
 	data := []byte(`[
 		{
@@ -553,7 +935,7 @@ func (s *orderServiceTestSuite) TestListLiquidationOrders() {
 			  "price": "7918.33",
 			  "origQty": "0.014",
 			  "executedQty": "0.014",
-			  "avragePrice": "7918.33",
+			  "averagePrice": "7918.33",
 			  "status": "FILLED",
 			  "timeInForce": "IOC",
 			  "type": "LIMIT",
@@ -593,6 +975,7 @@ func (s *orderServiceTestSuite) TestListLiquidationOrders() {
 			TimeInForce:      TimeInForceTypeIOC,
 			Type:             OrderTypeLimit,
 			Side:             SideTypeSell,
+			Time:             1568014460893,
 		},
 	}
 	s.r().Len(res, len(e))
@@ -612,4 +995,5 @@ func (s *orderServiceTestSuite) assertLiquidationEqual(e, a *LiquidationOrder) {
 	r.Equal(e.TimeInForce, a.TimeInForce, "TimeInForce")
 	r.Equal(e.Type, a.Type, "Type")
 	r.Equal(e.Side, a.Side, "Side")
+	r.Equal(e.Time, a.Time, "Time")
 }
@@ -0,0 +1,80 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrOrderStatusUnreachable is returned by WaitForOrderStatus when the order reaches a final status
+// other than the awaited one
+var ErrOrderStatusUnreachable = errors.New("order status unreachable")
+
+// IsFinal tell if an order of status t cannot change anymore
+func (t OrderStatusType) IsFinal() bool {
+	switch t {
+	case OrderStatusTypeFilled, OrderStatusTypeCanceled, OrderStatusTypeRejected, OrderStatusTypeExpired:
+		return true
+	}
+	return false
+}
+
+// WaitForOrderStatus serve the user data stream of listenKey until the order of orderID reaches targetStatus,
+// the order update is returned, or until ctx is done or timeout, when not zero, elapses.
+// Only the updates received once connected are seen, so the order should be placed after the call started,
+// or its status checked with GetOrderService once this returned a context error.
+// When the order reaches another final status its update is returned with ErrOrderStatusUnreachable.
+// Only the error closing the stream is returned, the decode errors and overflows of a running stream are ignored.
+func WaitForOrderStatus(ctx context.Context, listenKey string, orderID int64, targetStatus OrderStatusType, timeout time.Duration) (*WsOrderUpdate, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	updateC := make(chan *WsOrderUpdate, 1)
+	// decode errors and overflows are reported while the stream keeps running, only the error
+	// closing the connection, the last one reported before doneC is closed, aborts the wait
+	var mu sync.Mutex
+	var lastErr error
+	doneC, stopC, err := WsUserDataServe(listenKey, func(event *WsUserDataEvent) {
+		update := event.OrderTradeUpdate
+		if event.Event != UserDataEventTypeOrderTradeUpdate || update.ID != orderID {
+			return
+		}
+		if update.Status != targetStatus && !update.Status.IsFinal() {
+			return
+		}
+		select {
+		case updateC <- &update:
+		default:
+		}
+	}, func(err error) {
+		mu.Lock()
+		lastErr = err
+		mu.Unlock()
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer close(stopC)
+
+	select {
+	case update := <-updateC:
+		if update.Status != targetStatus {
+			return update, fmt.Errorf("%w: order %d is %s", ErrOrderStatusUnreachable, orderID, update.Status)
+		}
+		return update, nil
+	case <-doneC:
+		mu.Lock()
+		err := lastErr
+		mu.Unlock()
+		if err == nil {
+			err = errors.New("user data stream closed")
+		}
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,126 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+)
+
+func mockOrderStatusWsServe(t *testing.T, messages ...string) {
+	origWsServe := wsServe
+	t.Cleanup(func() {
+		wsServe = origWsServe
+	})
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		require.Equal(t, getWsEndpoint()+"/fakeListenKey", cfg.Endpoint)
+		for _, message := range messages {
+			handler([]byte(message))
+		}
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+}
+
+func orderUpdateMessage(orderID int64, status OrderStatusType) string {
+	return fmt.Sprintf(`{"e":"ORDER_TRADE_UPDATE","E":1,"T":1,"o":{"s":"BTCUSDT","i":%d,"X":"%s","z":"0.001"}}`, orderID, status)
+}
+
+func TestWaitForOrderStatus(t *testing.T) {
+	r := require.New(t)
+	mockOrderStatusWsServe(t,
+		`{"e":"ACCOUNT_UPDATE","E":1,"T":1,"a":{"m":"ORDER","B":[],"P":[]}}`,
+		orderUpdateMessage(2, OrderStatusTypeFilled),
+		orderUpdateMessage(1, OrderStatusTypeNew),
+		orderUpdateMessage(1, OrderStatusTypePartiallyFilled),
+		orderUpdateMessage(1, OrderStatusTypeFilled),
+	)
+
+	update, err := WaitForOrderStatus(context.Background(), "fakeListenKey", 1, OrderStatusTypeFilled, time.Second)
+	r.NoError(err)
+	r.Equal(int64(1), update.ID)
+	r.Equal(OrderStatusTypeFilled, update.Status)
+	r.Equal("0.001", update.AccumulatedFilledQty)
+}
+
+func TestWaitForOrderStatusUnreachable(t *testing.T) {
+	r := require.New(t)
+	mockOrderStatusWsServe(t,
+		orderUpdateMessage(1, OrderStatusTypeNew),
+		orderUpdateMessage(1, OrderStatusTypeCanceled),
+	)
+
+	update, err := WaitForOrderStatus(context.Background(), "fakeListenKey", 1, OrderStatusTypeFilled, time.Second)
+	r.ErrorIs(err, ErrOrderStatusUnreachable)
+	r.EqualError(err, "order status unreachable: order 1 is CANCELED")
+	r.Equal(OrderStatusTypeCanceled, update.Status)
+}
+
+func TestWaitForOrderStatusTimeout(t *testing.T) {
+	r := require.New(t)
+	mockOrderStatusWsServe(t, orderUpdateMessage(1, OrderStatusTypeNew))
+
+	_, err := WaitForOrderStatus(context.Background(), "fakeListenKey", 1, OrderStatusTypeFilled, 10*time.Millisecond)
+	r.ErrorIs(err, context.DeadlineExceeded)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = WaitForOrderStatus(ctx, "fakeListenKey", 1, OrderStatusTypeFilled, 0)
+	r.ErrorIs(err, context.Canceled)
+}
+
+func TestWaitForOrderStatusStreamError(t *testing.T) {
+	r := require.New(t)
+	origWsServe := wsServe
+	defer func() {
+		wsServe = origWsServe
+	}()
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return nil, nil, errors.New("dial failed")
+	}
+	_, err := WaitForOrderStatus(context.Background(), "fakeListenKey", 1, OrderStatusTypeFilled, time.Second)
+	r.EqualError(err, "dial failed")
+
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		errHandler(errors.New("connection reset"))
+		close(doneC)
+		return doneC, stopC, nil
+	}
+	_, err = WaitForOrderStatus(context.Background(), "fakeListenKey", 1, OrderStatusTypeFilled, time.Second)
+	r.EqualError(err, "connection reset")
+}
+
+func TestWaitForOrderStatusIgnoreStreamErrors(t *testing.T) {
+	r := require.New(t)
+	origWsServe := wsServe
+	defer func() {
+		wsServe = origWsServe
+	}()
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		handler([]byte(`{"e":"ORDER_TRADE_UPDATE","E":"invalid"}`))
+		errHandler(&common.WsOverflowError{Endpoint: cfg.Endpoint, Dropped: 1})
+		handler([]byte(orderUpdateMessage(1, OrderStatusTypeFilled)))
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+	update, err := WaitForOrderStatus(context.Background(), "fakeListenKey", 1, OrderStatusTypeFilled, time.Second)
+	r.NoError(err)
+	r.Equal(OrderStatusTypeFilled, update.Status)
+}
@@ -0,0 +1,178 @@
+package futures
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// FilterViolationError define the first symbol filter an order does not satisfy
+type FilterViolationError struct {
+	FilterType SymbolFilterType
+	Field      string
+	Value      string
+	Constraint string
+}
+
+// Error return filter type, field, value and violated constraint
+func (e FilterViolationError) Error() string {
+	return fmt.Sprintf("<FilterViolationError> filter=%s, field=%s, value=%s, constraint=%s", e.FilterType, e.Field, e.Value, e.Constraint)
+}
+
+// IsFilterViolationError check if e is a filter violation error
+func IsFilterViolationError(e error) bool {
+	_, ok := e.(*FilterViolationError)
+	return ok
+}
+
+// isMarketOrderType tell if the order type is executed at market, where MARKET_LOT_SIZE applies instead of LOT_SIZE
+func isMarketOrderType(t OrderType) bool {
+	switch t {
+	case OrderTypeMarket, OrderTypeStopMarket, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		return true
+	}
+	return false
+}
+
+func parseDecimal(s string) (*big.Rat, bool) {
+	if s == "" {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// checkRange check min <= value <= max and that value is a multiple of step from min,
+// a zero min, max or step disables the corresponding check
+func checkRange(filterType SymbolFilterType, field, value, min, max, step string) error {
+	v, ok := parseDecimal(value)
+	if !ok {
+		return &FilterViolationError{FilterType: filterType, Field: field, Value: value, Constraint: "invalid decimal"}
+	}
+	lo, hasMin := parseDecimal(min)
+	if hasMin && lo.Sign() > 0 && v.Cmp(lo) < 0 {
+		return &FilterViolationError{FilterType: filterType, Field: field, Value: value, Constraint: ">= " + min}
+	}
+	if hi, ok := parseDecimal(max); ok && hi.Sign() > 0 && v.Cmp(hi) > 0 {
+		return &FilterViolationError{FilterType: filterType, Field: field, Value: value, Constraint: "<= " + max}
+	}
+	if st, ok := parseDecimal(step); ok && st.Sign() > 0 {
+		base := new(big.Rat)
+		if hasMin {
+			base.Set(lo)
+		}
+		q := new(big.Rat).Quo(new(big.Rat).Sub(v, base), st)
+		if !q.IsInt() {
+			return &FilterViolationError{FilterType: filterType, Field: field, Value: value, Constraint: "multiple of " + step}
+		}
+	}
+	return nil
+}
+
+// ValidateOrder check an order against the symbol filters of info before sending it:
+// PRICE_FILTER, LOT_SIZE or MARKET_LOT_SIZE, MIN_NOTIONAL and PERCENT_PRICE.
+// markPrice is the reference price of PERCENT_PRICE and of the notional of market orders,
+// these checks are skipped when it is empty.
+// The first violation is returned as a *FilterViolationError.
+func ValidateOrder(info *ExchangeInfo, order *CreateOrderService, markPrice string) error {
+	var symbol *Symbol
+	for i := range info.Symbols {
+		if info.Symbols[i].Symbol == order.symbol {
+			symbol = &info.Symbols[i]
+			break
+		}
+	}
+	if symbol == nil {
+		return fmt.Errorf("symbol %s not found in exchange info", order.symbol)
+	}
+
+	if f := symbol.PriceFilter(); f != nil {
+		if order.price != nil {
+			if err := checkRange(SymbolFilterTypePrice, "price", *order.price, f.MinPrice, f.MaxPrice, f.TickSize); err != nil {
+				return err
+			}
+		}
+		if order.stopPrice != nil {
+			if err := checkRange(SymbolFilterTypePrice, "stopPrice", *order.stopPrice, f.MinPrice, f.MaxPrice, f.TickSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if order.quantity != "" {
+		if isMarketOrderType(order.orderType) {
+			if f := symbol.MarketLotSizeFilter(); f != nil {
+				if err := checkRange(SymbolFilterTypeMarketLotSize, "quantity", order.quantity, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+					return err
+				}
+			}
+		} else if f := symbol.LotSizeFilter(); f != nil {
+			if err := checkRange(SymbolFilterTypeLotSize, "quantity", order.quantity, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	price := markPrice
+	if order.price != nil && !isMarketOrderType(order.orderType) {
+		price = *order.price
+	}
+	if f := symbol.MinNotionalFilter(); f != nil && order.quantity != "" && price != "" {
+		p, _ := parseDecimal(price)
+		q, _ := parseDecimal(order.quantity)
+		min, ok := parseDecimal(f.Notional)
+		if p != nil && q != nil && ok {
+			notional := new(big.Rat).Mul(p, q)
+			if notional.Cmp(min) < 0 {
+				return &FilterViolationError{
+					FilterType: SymbolFilterTypeMinNotional,
+					Field:      "notional",
+					Value:      notional.FloatString(8),
+					Constraint: ">= " + f.Notional,
+				}
+			}
+		}
+	}
+
+	if f := symbol.PercentPriceFilter(); f != nil && order.price != nil && markPrice != "" {
+		mark, ok := parseDecimal(markPrice)
+		up, okUp := parseDecimal(f.MultiplierUp)
+		down, okDown := parseDecimal(f.MultiplierDown)
+		p, okPrice := parseDecimal(*order.price)
+		if ok && okUp && okDown && okPrice {
+			// binance checks buy orders against the upper bound and sell orders against the lower bound
+			if order.side == SideTypeBuy {
+				if hi := new(big.Rat).Mul(mark, up); p.Cmp(hi) > 0 {
+					return &FilterViolationError{FilterType: SymbolFilterTypePercentPrice, Field: "price", Value: *order.price, Constraint: "<= " + hi.FloatString(8)}
+				}
+			} else if lo := new(big.Rat).Mul(mark, down); p.Cmp(lo) < 0 {
+				return &FilterViolationError{FilterType: SymbolFilterTypePercentPrice, Field: "price", Value: *order.price, Constraint: ">= " + lo.FloatString(8)}
+			}
+		}
+	}
+	return nil
+}
+
+// CreateOrderRequest define an order to create, see CreateOrderService
+type CreateOrderRequest = CreateOrderService
+
+// ValidateFuturesOrder check an order against the symbol filters of info before sending it,
+// see ValidateOrder. The checks needing a mark price, PERCENT_PRICE and the notional of
+// market orders, are skipped, use ValidateOrder with the mark price for them.
+// The first violation is returned as a *FilterViolationError.
+func ValidateFuturesOrder(info *FuturesExchangeInfo, req *CreateOrderRequest) error {
+	return ValidateOrder(info, req, "")
+}
+
+// Validate check the parameters required by the order type and that they do not conflict,
+// then check the order against the symbol filters of info when it is not nil, see ValidateOrder
+func (s *CreateOrderService) Validate(info *ExchangeInfo, markPrice string) error {
+	if err := s.checkRequired(); err != nil {
+		return err
+	}
+	if err := s.validate(); err != nil {
+		return err
+	}
+	if info == nil {
+		return nil
+	}
+	return ValidateOrder(info, s, markPrice)
+}
@@ -0,0 +1,98 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderValidationTestSuite struct {
+	suite.Suite
+	info *ExchangeInfo
+	c    *Client
+}
+
+func TestOrderValidation(t *testing.T) {
+	suite.Run(t, new(orderValidationTestSuite))
+}
+
+func (s *orderValidationTestSuite) SetupTest() {
+	s.c = NewClient("dummyAPIKey", "dummySecretKey")
+	s.info = &ExchangeInfo{
+		Symbols: []Symbol{
+			{
+				Symbol: "BTCUSDT",
+				Filters: []map[string]interface{}{
+					{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+					{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+					{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.001"},
+					{"filterType": "MIN_NOTIONAL", "notional": "5"},
+					{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"},
+				},
+			},
+		},
+	}
+}
+
+func (s *orderValidationTestSuite) limit(side SideType, price, quantity string) *CreateOrderService {
+	return s.c.NewCreateOrderService().Symbol("BTCUSDT").Side(side).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Price(price).Quantity(quantity)
+}
+
+func (s *orderValidationTestSuite) assertViolation(err error, filterType SymbolFilterType, field string) {
+	r := s.Require()
+	r.Error(err)
+	r.True(IsFilterViolationError(err), err.Error())
+	v := err.(*FilterViolationError)
+	r.Equal(filterType, v.FilterType)
+	r.Equal(field, v.Field)
+}
+
+func (s *orderValidationTestSuite) TestValid() {
+	s.NoError(s.limit(SideTypeBuy, "30000.10", "0.010").Validate(s.info, "30000"))
+	s.NoError(ValidateOrder(s.info, s.c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("100"), "30000"))
+	// reference checks are skipped without mark price
+	s.NoError(s.limit(SideTypeBuy, "50000", "0.001").Validate(s.info, ""))
+}
+
+func (s *orderValidationTestSuite) TestUnknownSymbol() {
+	err := ValidateOrder(s.info, s.c.NewCreateOrderService().Symbol("ETHUSDT"), "")
+	s.Error(err)
+	s.False(IsFilterViolationError(err))
+}
+
+func (s *orderValidationTestSuite) TestPriceFilter() {
+	s.assertViolation(s.limit(SideTypeBuy, "30000.15", "0.01").Validate(s.info, ""), SymbolFilterTypePrice, "price")
+	s.assertViolation(s.limit(SideTypeBuy, "500", "0.01").Validate(s.info, ""), SymbolFilterTypePrice, "price")
+	s.assertViolation(s.limit(SideTypeBuy, "30000", "0.01").StopPrice("5000000").Validate(s.info, ""), SymbolFilterTypePrice, "stopPrice")
+}
+
+func (s *orderValidationTestSuite) TestLotSize() {
+	s.assertViolation(s.limit(SideTypeBuy, "30000", "0.0015").Validate(s.info, ""), SymbolFilterTypeLotSize, "quantity")
+	s.assertViolation(s.limit(SideTypeBuy, "30000", "1001").Validate(s.info, ""), SymbolFilterTypeLotSize, "quantity")
+	err := ValidateOrder(s.info, s.c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("121"), "")
+	s.assertViolation(err, SymbolFilterTypeMarketLotSize, "quantity")
+}
+
+func (s *orderValidationTestSuite) TestMinNotional() {
+	s.assertViolation(s.limit(SideTypeBuy, "1000", "0.001").Validate(s.info, ""), SymbolFilterTypeMinNotional, "notional")
+	err := ValidateOrder(s.info, s.c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("0.001"), "3000")
+	s.assertViolation(err, SymbolFilterTypeMinNotional, "notional")
+}
+
+func (s *orderValidationTestSuite) TestPercentPrice() {
+	s.assertViolation(s.limit(SideTypeBuy, "31600", "0.01").Validate(s.info, "30000"), SymbolFilterTypePercentPrice, "price")
+	s.assertViolation(s.limit(SideTypeSell, "28400", "0.01").Validate(s.info, "30000"), SymbolFilterTypePercentPrice, "price")
+	s.NoError(s.limit(SideTypeSell, "31600", "0.01").Validate(s.info, "30000"))
+}
+
+func (s *orderValidationTestSuite) TestValidateFuturesOrder() {
+	var req *CreateOrderRequest = s.limit(SideTypeBuy, "30000.10", "0.010")
+	s.NoError(ValidateFuturesOrder(s.info, req))
+	s.assertViolation(ValidateFuturesOrder(s.info, s.limit(SideTypeBuy, "30000.15", "0.01")), SymbolFilterTypePrice, "price")
+	s.assertViolation(ValidateFuturesOrder(s.info, s.limit(SideTypeBuy, "30000", "1001")), SymbolFilterTypeLotSize, "quantity")
+	s.assertViolation(ValidateFuturesOrder(s.info, s.limit(SideTypeBuy, "1000", "0.001")), SymbolFilterTypeMinNotional, "notional")
+}
@@ -0,0 +1,53 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetPMProAccountService get the portfolio margin pro account info of an asset
+type GetPMProAccountService struct {
+	c     *Client
+	asset string
+}
+
+// Asset set asset
+func (s *GetPMProAccountService) Asset(asset string) *GetPMProAccountService {
+	s.asset = asset
+	return s
+}
+
+// Do send request
+func (s *GetPMProAccountService) Do(ctx context.Context, opts ...RequestOption) (res *PMProAccount, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/pmAccountInfo",
+		secType:  secTypeSigned,
+	}
+	r.setParam("asset", s.asset)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PMProAccount)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PMProAccount define portfolio margin pro account info
+type PMProAccount struct {
+	Asset                  string `json:"asset"`
+	MaxWithdrawAmount      string `json:"maxWithdrawAmount"`
+	MaxWithdrawAmountUSD   string `json:"maxWithdrawAmountUSD"`
+	UniMMR                 string `json:"uniMMR"`
+	AccountEquity          string `json:"accountEquity"`
+	ActualEquityInBTC      string `json:"actualEquityInBTC"`
+	AccountInitialMargin   string `json:"accountInitialMargin"`
+	AccountMaintMargin     string `json:"accountMaintMargin"`
+	AccountStatus          string `json:"accountStatus"`
+	VirtualMaxNotionalUSDT string `json:"virtualMaxNotionalUSDT"`
+}
@@ -0,0 +1,53 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type pmAccountServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestPMAccountService(t *testing.T) {
+	suite.Run(t, new(pmAccountServiceTestSuite))
+}
+
+func (s *pmAccountServiceTestSuite) TestGetPMProAccount() {
+	data := []byte(`{
+		"maxWithdrawAmountUSD": "1627523.32459208",
+		"asset": "BTC",
+		"maxWithdrawAmount": "27.43689636",
+		"uniMMR": "5167.92171923",
+		"accountEquity": "122607.35137903",
+		"actualEquityInBTC": "2.04345585",
+		"accountInitialMargin": "23.72469206",
+		"accountMaintMargin": "23.72469206",
+		"accountStatus": "NORMAL",
+		"virtualMaxNotionalUSDT": "1000000"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	asset := "BTC"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("asset", asset)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetPMProAccountService().Asset(asset).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&PMProAccount{
+		Asset:                  "BTC",
+		MaxWithdrawAmount:      "27.43689636",
+		MaxWithdrawAmountUSD:   "1627523.32459208",
+		UniMMR:                 "5167.92171923",
+		AccountEquity:          "122607.35137903",
+		ActualEquityInBTC:      "2.04345585",
+		AccountInitialMargin:   "23.72469206",
+		AccountMaintMargin:     "23.72469206",
+		AccountStatus:          "NORMAL",
+		VirtualMaxNotionalUSDT: "1000000",
+	}, res)
+}
@@ -3,6 +3,7 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 )
 
@@ -22,7 +23,7 @@ func (s *GetPositionMarginHistoryService) Symbol(symbol string) *GetPositionMarg
 	return s
 }
 
-// Type set type
+// Type set type, PositionMarginTypeAdd or PositionMarginTypeReduce, both are listed when it is not set
 func (s *GetPositionMarginHistoryService) Type(_type int) *GetPositionMarginHistoryService {
 	s._type = &_type
 	return s
@@ -40,7 +41,7 @@ func (s *GetPositionMarginHistoryService) EndTime(endTime int64) *GetPositionMar
 	return s
 }
 
-// Limit set limit
+// Limit set limit, default 500
 func (s *GetPositionMarginHistoryService) Limit(limit int64) *GetPositionMarginHistoryService {
 	s.limit = &limit
 	return s
@@ -48,6 +49,12 @@ func (s *GetPositionMarginHistoryService) Limit(limit int64) *GetPositionMarginH
 
 // Do send request
 func (s *GetPositionMarginHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionMarginHistory, err error) {
+	if s._type != nil && *s._type != PositionMarginTypeAdd && *s._type != PositionMarginTypeReduce {
+		return nil, fmt.Errorf("%w: invalid type %d", ErrInvalidParam, *s._type)
+	}
+	if s.startTime != nil && s.endTime != nil && *s.endTime < *s.startTime {
+		return nil, fmt.Errorf("%w: endTime is before startTime", ErrInvalidParam)
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/positionMargin/history",
@@ -57,3 +57,78 @@ type PositionRisk struct {
 	Notional         string `json:"notional"`
 	IsolatedWallet   string `json:"isolatedWallet"`
 }
+
+// GetPositionRiskV3Service get the risk of the positions, unlike GetPositionRiskService only the symbols
+// with an open position or open orders are returned
+type GetPositionRiskV3Service struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetPositionRiskV3Service) Symbol(symbol string) *GetPositionRiskV3Service {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetPositionRiskV3Service) Do(ctx context.Context, opts ...RequestOption) (res []*PositionRiskV3, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v3/positionRisk",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.setParam("symbol", s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*PositionRiskV3{}, err
+	}
+	res = make([]*PositionRiskV3, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PositionRiskV3{}, err
+	}
+	return res, nil
+}
+
+// PositionRiskV3 define v3 position risk info
+type PositionRiskV3 struct {
+	Symbol                 string           `json:"symbol"`
+	PositionSide           PositionSideType `json:"positionSide"`
+	PositionAmt            string           `json:"positionAmt"`
+	EntryPrice             string           `json:"entryPrice"`
+	BreakEvenPrice         string           `json:"breakEvenPrice"`
+	MarkPrice              string           `json:"markPrice"`
+	UnRealizedProfit       string           `json:"unRealizedProfit"`
+	LiquidationPrice       string           `json:"liquidationPrice"`
+	IsolatedMargin         string           `json:"isolatedMargin"`
+	Notional               string           `json:"notional"`
+	MarginAsset            string           `json:"marginAsset"`
+	IsolatedWallet         string           `json:"isolatedWallet"`
+	InitialMargin          string           `json:"initialMargin"`
+	MaintMargin            string           `json:"maintMargin"`
+	PositionInitialMargin  string           `json:"positionInitialMargin"`
+	OpenOrderInitialMargin string           `json:"openOrderInitialMargin"`
+	Adl                    int64            `json:"adl"`
+	BidNotional            string           `json:"bidNotional"`
+	AskNotional            string           `json:"askNotional"`
+	UpdateTime             int64            `json:"updateTime"`
+}
+
+// OpenPositions get the positions of the account with a non zero amount, symbols with open orders
+// only are left out
+func (c *Client) OpenPositions(ctx context.Context, opts ...RequestOption) ([]*PositionRiskV3, error) {
+	positions, err := c.NewGetPositionRiskV3Service().Do(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*PositionRiskV3, 0, len(positions))
+	for _, p := range positions {
+		if amt, ok := parseDecimal(p.PositionAmt); ok && amt.Sign() != 0 {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
@@ -80,3 +80,83 @@ func (s *positionRiskServiceTestSuite) assertPositionRiskEqual(e, a *PositionRis
 	r.Equal(e.UnRealizedProfit, a.UnRealizedProfit, "UnRealizedProfit")
 	r.Equal(e.PositionSide, a.PositionSide, "PositionSide")
 }
+
+func (s *positionRiskServiceTestSuite) TestGetPositionRiskV3() {
+	data := []byte(`[
+		{
+			"symbol": "ADAUSDT",
+			"positionSide": "BOTH",
+			"positionAmt": "30",
+			"entryPrice": "0.385",
+			"breakEvenPrice": "0.385077",
+			"markPrice": "0.41047590",
+			"unRealizedProfit": "0.76427700",
+			"liquidationPrice": "0",
+			"isolatedMargin": "0",
+			"notional": "12.31427700",
+			"marginAsset": "USDT",
+			"isolatedWallet": "0",
+			"initialMargin": "0.61571385",
+			"maintMargin": "0.08004280",
+			"positionInitialMargin": "0.61571385",
+			"openOrderInitialMargin": "0",
+			"adl": 2,
+			"bidNotional": "0",
+			"askNotional": "0",
+			"updateTime": 1720736417660
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", "ADAUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetPositionRiskV3Service().Symbol("ADAUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(&PositionRiskV3{
+		Symbol:                 "ADAUSDT",
+		PositionSide:           PositionSideTypeBoth,
+		PositionAmt:            "30",
+		EntryPrice:             "0.385",
+		BreakEvenPrice:         "0.385077",
+		MarkPrice:              "0.41047590",
+		UnRealizedProfit:       "0.76427700",
+		LiquidationPrice:       "0",
+		IsolatedMargin:         "0",
+		Notional:               "12.31427700",
+		MarginAsset:            "USDT",
+		IsolatedWallet:         "0",
+		InitialMargin:          "0.61571385",
+		MaintMargin:            "0.08004280",
+		PositionInitialMargin:  "0.61571385",
+		OpenOrderInitialMargin: "0",
+		Adl:                    2,
+		BidNotional:            "0",
+		AskNotional:            "0",
+		UpdateTime:             1720736417660,
+	}, res[0])
+}
+
+func (s *positionRiskServiceTestSuite) TestOpenPositions() {
+	data := []byte(`[
+		{"symbol": "ADAUSDT", "positionSide": "LONG", "positionAmt": "30"},
+		{"symbol": "BTCUSDT", "positionSide": "BOTH", "positionAmt": "0.000", "openOrderInitialMargin": "12.5"},
+		{"symbol": "ETHUSDT", "positionSide": "SHORT", "positionAmt": "-0.5"}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.OpenPositions(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 2)
+	r.Equal("ADAUSDT", res[0].Symbol)
+	r.Equal("ETHUSDT", res[1].Symbol)
+}
@@ -3,7 +3,13 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 )
 
 // ChangeLeverageService change user's initial leverage of specific symbol market
@@ -11,6 +17,7 @@ type ChangeLeverageService struct {
 	c        *Client
 	symbol   string
 	leverage int
+	brackets *LeverageBracket
 }
 
 // Symbol set symbol
@@ -25,8 +32,41 @@ func (s *ChangeLeverageService) Leverage(leverage int) *ChangeLeverageService {
 	return s
 }
 
+// Brackets set the leverage brackets of the symbol, as returned by GetLeverageBracketService,
+// to reject a leverage above the maximum of the symbol before sending the request.
+// The notional cap implied by the leverage is logged when it is lower than the cap of the first bracket.
+func (s *ChangeLeverageService) Brackets(brackets *LeverageBracket) *ChangeLeverageService {
+	s.brackets = brackets
+	return s
+}
+
+func (s *ChangeLeverageService) validate() error {
+	if s.leverage < 1 {
+		return fmt.Errorf("%w: leverage %d is less than 1", ErrInvalidParam, s.leverage)
+	}
+	if s.brackets == nil {
+		return nil
+	}
+	if s.brackets.Symbol != "" && s.brackets.Symbol != s.symbol {
+		return fmt.Errorf("%w: brackets of %s given for %s", ErrInvalidParam, s.brackets.Symbol, s.symbol)
+	}
+	if maxLeverage := s.brackets.MaxLeverage(); s.leverage > maxLeverage {
+		return fmt.Errorf("%w: leverage %d is above the max leverage %d of %s", ErrInvalidParam, s.leverage, maxLeverage, s.symbol)
+	}
+	return nil
+}
+
 // Do send request
 func (s *ChangeLeverageService) Do(ctx context.Context, opts ...RequestOption) (res *SymbolLeverage, err error) {
+	if err = s.validate(); err != nil {
+		return nil, err
+	}
+	if s.brackets != nil {
+		if notionalCap := s.brackets.NotionalCap(s.leverage); notionalCap < s.brackets.NotionalCap(1) {
+			s.c.logger().Info("leverage caps the position notional", "symbol", s.symbol,
+				"leverage", s.leverage, "notionalCap", notionalCap)
+		}
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/leverage",
@@ -55,6 +95,24 @@ type SymbolLeverage struct {
 	Symbol           string `json:"symbol"`
 }
 
+// MaxNotional parse MaxNotionalValue, "INF" when the notional is not capped gives +Inf
+func (l *SymbolLeverage) MaxNotional() (float64, error) {
+	if strings.EqualFold(l.MaxNotionalValue, "INF") {
+		return math.Inf(1), nil
+	}
+	return strconv.ParseFloat(l.MaxNotionalValue, 64)
+}
+
+// ErrCodeNoNeedToChangeMarginType is the API error code returned by ChangeMarginTypeService
+// when the symbol already uses the requested margin type, see common.IsAPIErrorCode
+const ErrCodeNoNeedToChangeMarginType int64 = -4046
+
+// IsNoNeedToChangeMarginType return true if err is the error of ChangeMarginTypeService for a symbol
+// already using the margin type, callers setting a margin type unconditionally can treat it as success
+func IsNoNeedToChangeMarginType(err error) bool {
+	return common.IsAPIErrorCode(err, ErrCodeNoNeedToChangeMarginType)
+}
+
 // ChangeMarginTypeService change user's margin type of specific symbol market
 type ChangeMarginTypeService struct {
 	c          *Client
@@ -68,7 +126,7 @@ func (s *ChangeMarginTypeService) Symbol(symbol string) *ChangeMarginTypeService
 	return s
 }
 
-// MarginType set margin type
+// MarginType set margin type, MarginTypeIsolated or MarginTypeCrossed
 func (s *ChangeMarginTypeService) MarginType(marginType MarginType) *ChangeMarginTypeService {
 	s.marginType = marginType
 	return s
@@ -76,6 +134,9 @@ func (s *ChangeMarginTypeService) MarginType(marginType MarginType) *ChangeMargi
 
 // Do send request
 func (s *ChangeMarginTypeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	if err = validEnum("marginType", s.marginType); err != nil {
+		return err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/marginType",
@@ -92,6 +153,16 @@ func (s *ChangeMarginTypeService) Do(ctx context.Context, opts ...RequestOption)
 	return nil
 }
 
+// Action types of UpdatePositionMarginService and GetPositionMarginHistoryService
+const (
+	PositionMarginTypeAdd    = 1
+	PositionMarginTypeReduce = 2
+)
+
+// ErrCodeIsolatedBalanceInsufficient is the API error code returned by UpdatePositionMarginService
+// when the margin to add is more than the available balance, see common.IsAPIErrorCode
+const ErrCodeIsolatedBalanceInsufficient int64 = -4051
+
 // UpdatePositionMarginService update isolated position margin
 type UpdatePositionMarginService struct {
 	c            *Client
@@ -107,7 +178,7 @@ func (s *UpdatePositionMarginService) Symbol(symbol string) *UpdatePositionMargi
 	return s
 }
 
-// Side set side
+// PositionSide set positionSide, required in hedge mode
 func (s *UpdatePositionMarginService) PositionSide(positionSide PositionSideType) *UpdatePositionMarginService {
 	s.positionSide = &positionSide
 	return s
@@ -119,7 +190,7 @@ func (s *UpdatePositionMarginService) Amount(amount string) *UpdatePositionMargi
 	return s
 }
 
-// Type set action type: 1: Add postion margin，2: Reduce postion margin
+// Type set action type, PositionMarginTypeAdd or PositionMarginTypeReduce
 func (s *UpdatePositionMarginService) Type(actionType int) *UpdatePositionMarginService {
 	s.actionType = actionType
 	return s
@@ -127,6 +198,12 @@ func (s *UpdatePositionMarginService) Type(actionType int) *UpdatePositionMargin
 
 // Do send request
 func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	if s.actionType != PositionMarginTypeAdd && s.actionType != PositionMarginTypeReduce {
+		return fmt.Errorf("%w: invalid type %d", ErrInvalidParam, s.actionType)
+	}
+	if s.amount == "" {
+		return fmt.Errorf("%w: amount is required", ErrInvalidParam)
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/positionMargin",
@@ -266,3 +343,50 @@ func (s *GetMultiAssetModeService) Do(ctx context.Context, opts ...RequestOption
 	}
 	return res, nil
 }
+
+// GetMaxWithdrawableMarginService get the maximum margin which can be removed from an isolated position
+type GetMaxWithdrawableMarginService struct {
+	c            *Client
+	symbol       string
+	positionSide *PositionSideType
+}
+
+// Symbol set symbol
+func (s *GetMaxWithdrawableMarginService) Symbol(symbol string) *GetMaxWithdrawableMarginService {
+	s.symbol = symbol
+	return s
+}
+
+// PositionSide set positionSide, required in hedge mode
+func (s *GetMaxWithdrawableMarginService) PositionSide(positionSide PositionSideType) *GetMaxWithdrawableMarginService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Do send request
+func (s *GetMaxWithdrawableMarginService) Do(ctx context.Context, opts ...RequestOption) (res *MaxWithdrawableMargin, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/positionMargin/maxWithdraw",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.positionSide != nil {
+		r.setParam("positionSide", *s.positionSide)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MaxWithdrawableMargin)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MaxWithdrawableMargin define the maximum margin which can be removed from an isolated position
+type MaxWithdrawableMargin struct {
+	Amount string `json:"amount"`
+}
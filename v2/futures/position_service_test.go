@@ -1,8 +1,11 @@
 package futures
 
 import (
+	"math"
+	"net/http"
 	"testing"
 
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -41,6 +44,54 @@ func (s *positionServiceTestSuite) TestChangeLeverage() {
 	s.r().Equal(e.Symbol, res.Symbol, "Symbol")
 	s.r().Equal(e.Leverage, res.Leverage, "Leverage")
 	s.r().Equal(e.MaxNotionalValue, res.MaxNotionalValue, "MaxNotionalValue")
+	maxNotional, err := res.MaxNotional()
+	s.r().NoError(err)
+	s.r().Equal(1000000.0, maxNotional)
+}
+
+func (s *positionServiceTestSuite) TestChangeLeverageInfiniteNotional() {
+	data := []byte(`{
+		"leverage": 1,
+		"maxNotionalValue": "INF",
+		"symbol": "BTCUSDT"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	res, err := s.client.NewChangeLeverageService().Symbol("BTCUSDT").Leverage(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	maxNotional, err := res.MaxNotional()
+	r.NoError(err)
+	r.True(math.IsInf(maxNotional, 1))
+}
+
+func (s *positionServiceTestSuite) TestChangeLeverageBrackets() {
+	brackets := &LeverageBracket{
+		Symbol: "BTCUSDT",
+		Brackets: []Bracket{
+			{Bracket: 1, InitialLeverage: 125, NotionalCap: 50000},
+			{Bracket: 2, InitialLeverage: 100, NotionalCap: 250000},
+			{Bracket: 3, InitialLeverage: 50, NotionalCap: 1000000},
+		},
+	}
+	r := s.r()
+	r.Equal(125, brackets.MaxLeverage())
+	r.Equal(250000.0, brackets.NotionalCap(75))
+	r.Equal(0.0, brackets.NotionalCap(126))
+
+	_, err := s.client.NewChangeLeverageService().Symbol("BTCUSDT").Leverage(126).Brackets(brackets).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewChangeLeverageService().Symbol("ETHUSDT").Leverage(20).Brackets(brackets).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewChangeLeverageService().Symbol("BTCUSDT").Leverage(0).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+
+	data := []byte(`{"leverage": 75, "maxNotionalValue": "250000", "symbol": "BTCUSDT"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	res, err := s.client.NewChangeLeverageService().Symbol("BTCUSDT").Leverage(75).Brackets(brackets).Do(newContext())
+	r.NoError(err)
+	r.Equal(75, res.Leverage)
 }
 
 func (s *positionServiceTestSuite) TestChangeMarginType() {
@@ -91,6 +142,26 @@ func (s *positionServiceTestSuite) TestUpdatePositionMargin() {
 	s.r().NoError(err)
 }
 
+func (s *positionServiceTestSuite) TestUpdatePositionMarginInsufficientBalance() {
+	data := []byte(`{"code": -4051, "msg": "Isolated balance insufficient."}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	err := s.client.NewUpdatePositionMarginService().Symbol("BTCUSDT").
+		Amount("100.0").Type(PositionMarginTypeReduce).Do(newContext())
+	r := s.r()
+	r.True(common.IsAPIErrorCode(err, ErrCodeIsolatedBalanceInsufficient))
+	r.False(common.IsAPIErrorCode(err, ErrCodeNoNeedToChangeMarginType))
+}
+
+func (s *positionServiceTestSuite) TestUpdatePositionMarginInvalidParams() {
+	r := s.r()
+	err := s.client.NewUpdatePositionMarginService().Symbol("BTCUSDT").Amount("100.0").Type(3).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	err = s.client.NewUpdatePositionMarginService().Symbol("BTCUSDT").Type(PositionMarginTypeAdd).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+}
+
 func (s *positionServiceTestSuite) TestChangePositionMode() {
 	data := []byte(`{
 		"code": 200,
@@ -154,3 +225,22 @@ func (s *positionServiceTestSuite) TestGetMultiAssetMode() {
 	s.r().NoError(err)
 	s.r().Equal(res.MultiAssetsMargin, true)
 }
+
+func (s *positionServiceTestSuite) TestGetMaxWithdrawableMargin() {
+	data := []byte(`{
+		"amount": "12.34567890"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":       "BTCUSDT",
+			"positionSide": PositionSideTypeLong,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetMaxWithdrawableMarginService().Symbol("BTCUSDT").
+		PositionSide(PositionSideTypeLong).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MaxWithdrawableMargin{Amount: "12.34567890"}, res)
+}
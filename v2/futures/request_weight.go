@@ -0,0 +1,148 @@
+package futures
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// LimitWeight define the weight of requests whose limit param is at most MaxLimit
+type LimitWeight struct {
+	MaxLimit int
+	Weight   int
+}
+
+// EndpointWeight define the request weight of an endpoint
+type EndpointWeight struct {
+	Weight int
+	// NoSymbolWeight overrides Weight when the request has no symbol param,
+	// for the endpoints returning every symbol in that case
+	NoSymbolWeight int
+	// DefaultLimit is the limit applied by the server when the request has none
+	DefaultLimit int
+	// LimitWeights override Weight according to the limit param, sorted by MaxLimit,
+	// the last bucket applies to limits above every MaxLimit
+	LimitWeights []LimitWeight
+}
+
+// weight return the weight of a request with the given params
+func (w EndpointWeight) weight(params url.Values) int {
+	if w.NoSymbolWeight > 0 && params.Get("symbol") == "" {
+		return w.NoSymbolWeight
+	}
+	if len(w.LimitWeights) == 0 {
+		return w.Weight
+	}
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	if limit <= 0 {
+		limit = w.DefaultLimit
+	}
+	for _, b := range w.LimitWeights {
+		if limit <= b.MaxLimit {
+			return b.Weight
+		}
+	}
+	return w.LimitWeights[len(w.LimitWeights)-1].Weight
+}
+
+// DefaultEndpointWeight is the weight of endpoints missing from EndpointWeights
+const DefaultEndpointWeight = 1
+
+// EndpointWeights is the request weight of the futures API endpoints, keyed by method and path
+var EndpointWeights = map[string]EndpointWeight{
+	"GET /fapi/v1/ping":         {Weight: 1},
+	"GET /fapi/v1/time":         {Weight: 1},
+	"GET /fapi/v1/exchangeInfo": {Weight: 1},
+	"GET /fapi/v1/depth": {DefaultLimit: 500, LimitWeights: []LimitWeight{
+		{MaxLimit: 50, Weight: 2},
+		{MaxLimit: 100, Weight: 5},
+		{MaxLimit: 500, Weight: 10},
+		{MaxLimit: 1000, Weight: 20},
+	}},
+	"GET /fapi/v1/trades":           {Weight: 5},
+	"GET /fapi/v1/historicalTrades": {Weight: 20},
+	"GET /fapi/v1/aggTrades":        {Weight: 20},
+	"GET /fapi/v1/klines": {DefaultLimit: 500, LimitWeights: []LimitWeight{
+		{MaxLimit: 99, Weight: 1},
+		{MaxLimit: 499, Weight: 2},
+		{MaxLimit: 1000, Weight: 5},
+		{MaxLimit: 1500, Weight: 10},
+	}},
+	"GET /fapi/v1/ticker/24hr":       {Weight: 1, NoSymbolWeight: 40},
+	"GET /fapi/v1/ticker/price":      {Weight: 1, NoSymbolWeight: 2},
+	"GET /fapi/v2/ticker/price":      {Weight: 1, NoSymbolWeight: 2},
+	"GET /fapi/v1/ticker/bookTicker": {Weight: 2, NoSymbolWeight: 5},
+	"POST /fapi/v1/order":            {Weight: 1},
+	"GET /fapi/v1/order":             {Weight: 1},
+	"DELETE /fapi/v1/order":          {Weight: 1},
+	"GET /fapi/v1/openOrders":        {Weight: 1, NoSymbolWeight: 40},
+	"GET /fapi/v1/allOrders":         {Weight: 5},
+	"GET /fapi/v2/account":           {Weight: 5},
+	"GET /fapi/v2/balance":           {Weight: 5},
+	"GET /fapi/v2/positionRisk":      {Weight: 5},
+	"GET /fapi/v3/positionRisk":      {Weight: 5},
+	"GET /fapi/v1/symbolConfig":      {Weight: 5},
+	"GET /fapi/v1/commissionRate":    {Weight: 20},
+	"GET /fapi/v1/userTrades":        {Weight: 5},
+	"GET /fapi/v1/income":            {Weight: 30},
+}
+
+// EstimateWeight return the request weight of an endpoint called with params, see EndpointWeights
+func (c *Client) EstimateWeight(method, endpoint string, params url.Values) int {
+	w, ok := EndpointWeights[method+" "+endpoint]
+	if !ok {
+		return DefaultEndpointWeight
+	}
+	return w.weight(params)
+}
+
+// EstimatedUsedWeight return the request weight used in the current minute,
+// as last reported by the server plus the estimated weight of the requests sent since
+func (c *Client) EstimatedUsedWeight() int64 {
+	return c.usedWeight.get(time.Now())
+}
+
+// weightTracker track the used request weight of the current one minute window
+type weightTracker struct {
+	mu     sync.Mutex
+	window int64
+	used   int64
+}
+
+func (t *weightTracker) reset(now time.Time) {
+	if w := now.Unix() / 60; w != t.window {
+		t.window = w
+		t.used = 0
+	}
+}
+
+func (t *weightTracker) get(now time.Time) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset(now)
+	return t.used
+}
+
+func (t *weightTracker) add(now time.Time, weight int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset(now)
+	t.used += int64(weight)
+}
+
+// update correct the estimation with the used weight reported in the response header
+func (t *weightTracker) update(now time.Time, header http.Header) {
+	if header.Get("X-Mbx-Used-Weight-1m") == "" {
+		return
+	}
+	used, err := strconv.ParseInt(header.Get("X-Mbx-Used-Weight-1m"), 10, 64)
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset(now)
+	t.used = used
+}
@@ -0,0 +1,71 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateWeight(t *testing.T) {
+	c := NewClient("key", "secret")
+	symbol := url.Values{"symbol": []string{"BTCUSDT"}}
+	limit := func(l string) url.Values {
+		return url.Values{"symbol": []string{"BTCUSDT"}, "limit": []string{l}}
+	}
+	tests := []struct {
+		name     string
+		method   string
+		endpoint string
+		params   url.Values
+		weight   int
+	}{
+		{"ticker price with symbol", http.MethodGet, "/fapi/v1/ticker/price", symbol, 1},
+		{"ticker price without symbol", http.MethodGet, "/fapi/v1/ticker/price", url.Values{}, 2},
+		{"ticker 24hr without symbol", http.MethodGet, "/fapi/v1/ticker/24hr", nil, 40},
+		{"depth default limit", http.MethodGet, "/fapi/v1/depth", symbol, 10},
+		{"depth 5", http.MethodGet, "/fapi/v1/depth", limit("5"), 2},
+		{"depth 100", http.MethodGet, "/fapi/v1/depth", limit("100"), 5},
+		{"depth 1000", http.MethodGet, "/fapi/v1/depth", limit("1000"), 20},
+		{"klines 1500", http.MethodGet, "/fapi/v1/klines", limit("1500"), 10},
+		{"account", http.MethodGet, "/fapi/v2/account", nil, 5},
+		{"unknown endpoint", http.MethodGet, "/fapi/v1/unknown", nil, DefaultEndpointWeight},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.weight, c.EstimateWeight(tt.method, tt.endpoint, tt.params))
+		})
+	}
+}
+
+func TestEstimatedUsedWeight(t *testing.T) {
+	r := require.New(t)
+	usedWeight := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if usedWeight != "" {
+			w.Header().Set("X-MBX-USED-WEIGHT-1M", usedWeight)
+		}
+		w.Write([]byte(`[{"symbol": "BTCUSDT", "price": "6000.01"}]`))
+	}))
+	defer server.Close()
+
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	_, err := c.NewListPricesService().Symbol("BTCUSDT").Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(1), c.EstimatedUsedWeight())
+
+	_, err = c.NewListPricesService().Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(3), c.EstimatedUsedWeight())
+
+	// the weight reported by the server replaces the estimation
+	usedWeight = "120"
+	_, err = c.NewListPricesService().Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(120), c.EstimatedUsedWeight())
+}
@@ -0,0 +1,50 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetSymbolConfigService get the margin type and leverage of the symbols of the account
+type GetSymbolConfigService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol, all the symbols are returned when it is not set
+func (s *GetSymbolConfigService) Symbol(symbol string) *GetSymbolConfigService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetSymbolConfigService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolConfig, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/symbolConfig",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.setParam("symbol", s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*SymbolConfig{}, err
+	}
+	res = make([]*SymbolConfig, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*SymbolConfig{}, err
+	}
+	return res, nil
+}
+
+// SymbolConfig define the margin type and leverage of a symbol
+type SymbolConfig struct {
+	Symbol           string     `json:"symbol"`
+	MarginType       MarginType `json:"marginType"`
+	IsAutoAddMargin  string     `json:"isAutoAddMargin"`
+	Leverage         int        `json:"leverage"`
+	MaxNotionalValue string     `json:"maxNotionalValue"`
+}
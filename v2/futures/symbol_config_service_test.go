@@ -0,0 +1,57 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeMarginTypeRoundTrip(t *testing.T) {
+	r := require.New(t)
+	marginTypes := map[string]string{"BTCUSDT": "CROSSED"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.NoError(req.ParseForm())
+		symbol := req.Form.Get("symbol")
+		switch req.Method + " " + req.URL.Path {
+		case "POST /fapi/v1/marginType":
+			if marginTypes[symbol] == req.Form.Get("marginType") {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"code": -4046, "msg": "No need to change margin type."}`))
+				return
+			}
+			marginTypes[symbol] = req.Form.Get("marginType")
+			w.Write([]byte(`{"code": 200, "msg": "success"}`))
+		case "GET /fapi/v1/symbolConfig":
+			fmt.Fprintf(w, `[{"symbol": %q, "marginType": %q, "isAutoAddMargin": "false", "leverage": 21, "maxNotionalValue": "1000000"}]`,
+				symbol, marginTypes[symbol])
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+	ctx := context.Background()
+
+	err := c.NewChangeMarginTypeService().Symbol("BTCUSDT").MarginType(MarginTypeIsolated).Do(ctx)
+	r.NoError(err)
+	configs, err := c.NewGetSymbolConfigService().Symbol("BTCUSDT").Do(ctx)
+	r.NoError(err)
+	r.Equal([]*SymbolConfig{{
+		Symbol:           "BTCUSDT",
+		MarginType:       MarginTypeIsolated,
+		IsAutoAddMargin:  "false",
+		Leverage:         21,
+		MaxNotionalValue: "1000000",
+	}}, configs)
+
+	err = c.NewChangeMarginTypeService().Symbol("BTCUSDT").MarginType(MarginTypeIsolated).Do(ctx)
+	r.True(IsNoNeedToChangeMarginType(err))
+	r.True(common.IsAPIErrorCode(err, -4046))
+	r.False(IsNoNeedToChangeMarginType(nil))
+}
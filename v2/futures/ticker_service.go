@@ -10,16 +10,27 @@ import (
 
 // ListBookTickersService list best price/qty on the order book for a symbol or symbols
 type ListBookTickersService struct {
-	c      *Client
-	symbol *string
+	c       *Client
+	symbol  *string
+	symbols []string
 }
 
+// GetFuturesBookTickerService list best price/qty on the order book, see ListBookTickersService
+type GetFuturesBookTickerService = ListBookTickersService
+
 // Symbol set symbol
 func (s *ListBookTickersService) Symbol(symbol string) *ListBookTickersService {
 	s.symbol = &symbol
 	return s
 }
 
+// Symbols set symbols, it is ignored when symbol is set.
+// The endpoint has no symbols param, the tickers of all symbols are requested then filtered.
+func (s *ListBookTickersService) Symbols(symbols []string) *ListBookTickersService {
+	s.symbols = symbols
+	return s
+}
+
 // Do send request
 func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption) (res []*BookTicker, err error) {
 	r := &request{
@@ -30,15 +41,25 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 		r.setParam("symbol", *s.symbol)
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
-	data = common.ToJSONList(data)
 	if err != nil {
 		return []*BookTicker{}, err
 	}
+	data = common.ToJSONList(data)
 	res = make([]*BookTicker, 0)
 	err = json.Unmarshal(data, &res)
 	if err != nil {
 		return []*BookTicker{}, err
 	}
+	if s.symbol == nil && len(s.symbols) != 0 {
+		in := symbolSet(s.symbols)
+		filtered := make([]*BookTicker, 0, len(s.symbols))
+		for _, t := range res {
+			if in[t.Symbol] {
+				filtered = append(filtered, t)
+			}
+		}
+		return filtered, nil
+	}
 	return res, nil
 }
 
@@ -49,20 +70,33 @@ type BookTicker struct {
 	BidQuantity string `json:"bidQty"`
 	AskPrice    string `json:"askPrice"`
 	AskQuantity string `json:"askQty"`
+	Time        int64  `json:"time"`
 }
 
-// ListPricesService list latest price for a symbol or symbols
+// ListPricesService list latest price for a symbol or symbols.
+// The request weight is 1 with a symbol and 2 without, when the prices of all symbols are returned.
 type ListPricesService struct {
-	c      *Client
-	symbol *string
+	c       *Client
+	symbol  *string
+	symbols []string
 }
 
+// GetFuturesTickerPriceService list latest price, see ListPricesService
+type GetFuturesTickerPriceService = ListPricesService
+
 // Symbol set symbol
 func (s *ListPricesService) Symbol(symbol string) *ListPricesService {
 	s.symbol = &symbol
 	return s
 }
 
+// Symbols set symbols, it is ignored when symbol is set.
+// The endpoint has no symbols param, the prices of all symbols are requested then filtered.
+func (s *ListPricesService) Symbols(symbols []string) *ListPricesService {
+	s.symbols = symbols
+	return s
+}
+
 // Do send request
 func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolPrice, err error) {
 	r := &request{
@@ -82,21 +116,47 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 	if err != nil {
 		return []*SymbolPrice{}, err
 	}
+	if s.symbol == nil && len(s.symbols) != 0 {
+		in := symbolSet(s.symbols)
+		filtered := make([]*SymbolPrice, 0, len(s.symbols))
+		for _, p := range res {
+			if in[p.Symbol] {
+				filtered = append(filtered, p)
+			}
+		}
+		return filtered, nil
+	}
 	return res, nil
 }
 
+// symbolSet return the set of symbols
+func symbolSet(symbols []string) map[string]bool {
+	set := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		set[symbol] = true
+	}
+	return set
+}
+
 // SymbolPrice define symbol and price pair
 type SymbolPrice struct {
 	Symbol string `json:"symbol"`
 	Price  string `json:"price"`
+	Time   int64  `json:"time"`
 }
 
+// TickerPrice define symbol and price pair, see SymbolPrice
+type TickerPrice = SymbolPrice
+
 // ListPriceChangeStatsService show stats of price change in last 24 hours for all symbols
 type ListPriceChangeStatsService struct {
 	c      *Client
 	symbol *string
 }
 
+// GetFutures24hrTickerService show stats of price change in last 24 hours, see ListPriceChangeStatsService
+type GetFutures24hrTickerService = ListPriceChangeStatsService
+
 // Symbol set symbol
 func (s *ListPriceChangeStatsService) Symbol(symbol string) *ListPriceChangeStatsService {
 	s.symbol = &symbol
@@ -67,7 +67,8 @@ func (s *tickerServiceTestSuite) TestSingleBookTicker() {
             "bidPrice": "4.00000000",
             "bidQty": "431.00000000",
             "askPrice": "4.00000200",
-            "askQty": "9.00000000"
+            "askQty": "9.00000000",
+            "time": 1589437530011
         }`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
@@ -79,7 +80,8 @@ func (s *tickerServiceTestSuite) TestSingleBookTicker() {
 		s.assertRequestEqual(e, r)
 	})
 
-	tickers, err := s.client.NewListBookTickersService().Symbol("LTCBTC").Do(newContext())
+	var service *GetFuturesBookTickerService = s.client.NewListBookTickersService()
+	tickers, err := service.Symbol("LTCBTC").Do(newContext())
 	r := s.r()
 	r.NoError(err)
 	r.Len(tickers, 1)
@@ -89,6 +91,7 @@ func (s *tickerServiceTestSuite) TestSingleBookTicker() {
 		BidQuantity: "431.00000000",
 		AskPrice:    "4.00000200",
 		AskQuantity: "9.00000000",
+		Time:        1589437530011,
 	}
 	s.assertBookTickerEqual(e, tickers[0])
 }
@@ -100,6 +103,7 @@ func (s *tickerServiceTestSuite) assertBookTickerEqual(e, a *BookTicker) {
 	r.Equal(e.BidQuantity, a.BidQuantity, "BidQuantity")
 	r.Equal(e.AskPrice, a.AskPrice, "AskPrice")
 	r.Equal(e.AskQuantity, a.AskQuantity, "AskQuantity")
+	r.Equal(e.Time, a.Time, "Time")
 }
 
 func (s *tickerServiceTestSuite) TestListPrices() {
@@ -137,10 +141,42 @@ func (s *tickerServiceTestSuite) TestListPrices() {
 	s.assertSymbolPriceEqual(e2, prices[1])
 }
 
+func (s *tickerServiceTestSuite) TestListPricesForMultipleSymbols() {
+	data := []byte(`[
+        {
+            "symbol": "LTCBTC",
+            "price": "4.00000200"
+        },
+        {
+            "symbol": "ETHBTC",
+            "price": "0.07946600"
+        },
+        {
+            "symbol": "BTCUSDT",
+            "price": "6000.01"
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	prices, err := s.client.NewListPricesService().Symbols([]string{"BTCUSDT", "LTCBTC"}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(prices, 2)
+	s.assertSymbolPriceEqual(&SymbolPrice{Symbol: "LTCBTC", Price: "4.00000200"}, prices[0])
+	s.assertSymbolPriceEqual(&SymbolPrice{Symbol: "BTCUSDT", Price: "6000.01"}, prices[1])
+}
+
 func (s *tickerServiceTestSuite) TestListSinglePrice() {
 	data := []byte(`{
 		"symbol": "LTCBTC",
-		"price": "4.00000200"
+		"price": "4.00000200",
+		"time": 1589437530011
 	}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
@@ -151,13 +187,15 @@ func (s *tickerServiceTestSuite) TestListSinglePrice() {
 		s.assertRequestEqual(e, r)
 	})
 
-	prices, err := s.client.NewListPricesService().Symbol(symbol).Do(newContext())
+	var service *GetFuturesTickerPriceService = s.client.NewListPricesService()
+	prices, err := service.Symbol(symbol).Do(newContext())
 	r := s.r()
 	r.NoError(err)
 	r.Len(prices, 1)
-	e1 := &SymbolPrice{
+	e1 := &TickerPrice{
 		Symbol: "LTCBTC",
 		Price:  "4.00000200",
+		Time:   1589437530011,
 	}
 	s.assertSymbolPriceEqual(e1, prices[0])
 }
@@ -166,6 +204,7 @@ func (s *tickerServiceTestSuite) assertSymbolPriceEqual(e, a *SymbolPrice) {
 	r := s.r()
 	r.Equal(e.Price, a.Price, "Price")
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
+	r.Equal(e.Time, a.Time, "Time")
 }
 
 func (s *tickerServiceTestSuite) TestPriceChangeStats() {
@@ -196,7 +235,8 @@ func (s *tickerServiceTestSuite) TestPriceChangeStats() {
 		e := newRequest().setParam("symbol", symbol)
 		s.assertRequestEqual(e, r)
 	})
-	stats, err := s.client.NewListPriceChangeStatsService().Symbol(symbol).Do(newContext())
+	var service *GetFutures24hrTickerService = s.client.NewListPriceChangeStatsService()
+	stats, err := service.Symbol(symbol).Do(newContext())
 	r := s.r()
 	r.NoError(err)
 	r.Len(stats, 1)
@@ -3,6 +3,8 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 )
 
@@ -14,6 +16,9 @@ type HistoricalTradesService struct {
 	fromID *int64
 }
 
+// GetFuturesHistoricalTradesService list historical trades, see HistoricalTradesService
+type GetFuturesHistoricalTradesService = HistoricalTradesService
+
 // Symbol set symbol
 func (s *HistoricalTradesService) Symbol(symbol string) *HistoricalTradesService {
 	s.symbol = symbol
@@ -127,6 +132,9 @@ func (s *AggTradesService) Limit(limit int) *AggTradesService {
 
 // Do send request
 func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*AggTrade, err error) {
+	if s.fromID != nil && (s.startTime != nil || s.endTime != nil) {
+		return []*AggTrade{}, errors.New("fromId cannot be sent with startTime or endTime")
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/aggTrades",
@@ -156,6 +164,9 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	return res, nil
 }
 
+// GetFuturesAggTradesService list the compressed aggregate trades of a symbol, see AggTradesService
+type GetFuturesAggTradesService = AggTradesService
+
 // AggTrade define aggregate trade info
 type AggTrade struct {
 	AggTradeID   int64  `json:"a"`
@@ -174,6 +185,9 @@ type RecentTradesService struct {
 	limit  *int
 }
 
+// GetFuturesRecentTradesService list recent trades, see RecentTradesService
+type GetFuturesRecentTradesService = RecentTradesService
+
 // Symbol set symbol
 func (s *RecentTradesService) Symbol(symbol string) *RecentTradesService {
 	s.symbol = symbol
@@ -225,31 +239,31 @@ func (s *ListAccountTradeService) Symbol(symbol string) *ListAccountTradeService
 	return s
 }
 
-// OrderID set orderId
+// OrderID set orderId, only the trades of this order are listed
 func (s *ListAccountTradeService) OrderID(orderID int64) *ListAccountTradeService {
 	s.orderId = &orderID
 	return s
 }
 
-// StartTime set startTime
+// StartTime set startTime, it cannot be sent with fromId
 func (s *ListAccountTradeService) StartTime(startTime int64) *ListAccountTradeService {
 	s.startTime = &startTime
 	return s
 }
 
-// EndTime set endTime
+// EndTime set endTime, it must be at most 7 days after startTime and cannot be sent with fromId
 func (s *ListAccountTradeService) EndTime(endTime int64) *ListAccountTradeService {
 	s.endTime = &endTime
 	return s
 }
 
-// FromID set fromID
+// FromID set fromID, it cannot be sent with startTime or endTime
 func (s *ListAccountTradeService) FromID(fromID int64) *ListAccountTradeService {
 	s.fromID = &fromID
 	return s
 }
 
-// Limit set limit
+// Limit set limit, default 500 and max 1000
 func (s *ListAccountTradeService) Limit(limit int) *ListAccountTradeService {
 	s.limit = &limit
 	return s
@@ -257,6 +271,9 @@ func (s *ListAccountTradeService) Limit(limit int) *ListAccountTradeService {
 
 // Do send request
 func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption) (res []*AccountTrade, err error) {
+	if err = s.validate(); err != nil {
+		return []*AccountTrade{}, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/userTrades",
@@ -273,7 +290,7 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 		r.setParam("endTime", *s.endTime)
 	}
 	if s.fromID != nil {
-		r.setParam("fromID", *s.fromID)
+		r.setParam("fromId", *s.fromID)
 	}
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
@@ -290,6 +307,39 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+func (s *ListAccountTradeService) validate() error {
+	if s.symbol == "" {
+		return fmt.Errorf("%w: symbol is required", ErrInvalidParam)
+	}
+	if s.fromID != nil && (s.startTime != nil || s.endTime != nil) {
+		return fmt.Errorf("%w: fromId cannot be sent with startTime or endTime", ErrInvalidParam)
+	}
+	if s.startTime != nil && s.endTime != nil {
+		if *s.endTime < *s.startTime {
+			return fmt.Errorf("%w: endTime is before startTime", ErrInvalidParam)
+		}
+		if *s.endTime-*s.startTime > archiveWindow {
+			return fmt.Errorf("%w: startTime and endTime cannot be more than 7 days apart", ErrInvalidParam)
+		}
+	}
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		return fmt.Errorf("%w: limit %d is out of [1, 1000]", ErrInvalidParam, *s.limit)
+	}
+	return nil
+}
+
+// TradesForOrder list the trades of the account filling the order of orderID on symbol,
+// at most 1000 of them
+func (c *Client) TradesForOrder(ctx context.Context, symbol string, orderID int64, opts ...RequestOption) ([]*AccountTrade, error) {
+	return c.NewListAccountTradeService().Symbol(symbol).OrderID(orderID).Limit(1000).Do(ctx, opts...)
+}
+
+// GetFuturesUserTradesService list the trades of the account, see ListAccountTradeService
+type GetFuturesUserTradesService = ListAccountTradeService
+
+// UserTrade define a trade of the account, see AccountTrade
+type UserTrade = AccountTrade
+
 // AccountTrade define account trade
 type AccountTrade struct {
 	Buyer           bool             `json:"buyer"`
@@ -297,6 +347,7 @@ type AccountTrade struct {
 	CommissionAsset string           `json:"commissionAsset"`
 	ID              int64            `json:"id"`
 	Maker           bool             `json:"maker"`
+	MarginAsset     string           `json:"marginAsset"`
 	OrderID         int64            `json:"orderId"`
 	Price           string           `json:"price"`
 	Quantity        string           `json:"qty"`
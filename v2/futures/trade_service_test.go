@@ -30,14 +30,12 @@ func (s *tradeServiceTestSuite) TestAggregateTrades() {
 	defer s.assertDo()
 
 	symbol := "LTCBTC"
-	fromID := int64(1)
 	startTime := int64(1498793709153)
 	endTime := int64(1498793709156)
 	limit := 1
 	s.assertReq(func(r *request) {
 		e := newRequest().setParams(params{
 			"symbol":    symbol,
-			"fromId":    fromID,
 			"startTime": startTime,
 			"endTime":   endTime,
 			"limit":     limit,
@@ -46,7 +44,7 @@ func (s *tradeServiceTestSuite) TestAggregateTrades() {
 	})
 
 	aggTrades, err := s.client.NewAggTradesService().Symbol(symbol).
-		FromID(fromID).StartTime(startTime).EndTime(endTime).Limit(limit).
+		StartTime(startTime).EndTime(endTime).Limit(limit).
 		Do(newContext())
 	r := s.r()
 	r.NoError(err)
@@ -63,6 +61,13 @@ func (s *tradeServiceTestSuite) TestAggregateTrades() {
 	s.assertAggTradeEqual(e, aggTrades[0])
 }
 
+func (s *tradeServiceTestSuite) TestAggregateTradesFromIDWithTime() {
+	_, err := s.client.NewAggTradesService().Symbol("LTCBTC").
+		FromID(1).StartTime(1498793709153).
+		Do(newContext())
+	s.r().EqualError(err, "fromId cannot be sent with startTime or endTime")
+}
+
 func (s *tradeServiceTestSuite) assertAggTradeEqual(e, a *AggTrade) {
 	r := s.r()
 	r.Equal(e.AggTradeID, a.AggTradeID, "AggTradeID")
@@ -98,9 +103,12 @@ func (s *tradeServiceTestSuite) TestHistoricalTrades() {
 			"fromId": fromID,
 		})
 		s.assertRequestEqual(e, r)
+		// the API key is required but the request is not signed
+		s.r().Equal(s.apiKey, r.header.Get("X-MBX-APIKEY"))
 	})
 
-	trades, err := s.client.NewHistoricalTradesService().Symbol(symbol).
+	var service *GetFuturesHistoricalTradesService = s.client.NewHistoricalTradesService()
+	trades, err := service.Symbol(symbol).
 		Limit(limit).FromID(fromID).Do(newContext())
 	r := s.r()
 	r.NoError(err)
@@ -138,9 +146,11 @@ func (s *tradeServiceTestSuite) TestRecentTrades() {
 			"limit":  limit,
 		})
 		s.assertRequestEqual(e, r)
+		s.r().Empty(r.header.Get("X-MBX-APIKEY"))
 	})
 
-	trades, err := s.client.NewRecentTradesService().Symbol(symbol).Limit(limit).Do(newContext())
+	var service *GetFuturesRecentTradesService = s.client.NewRecentTradesService()
+	trades, err := service.Symbol(symbol).Limit(limit).Do(newContext())
 	r := s.r()
 	r.NoError(err)
 	r.Len(trades, 1)
@@ -173,6 +183,7 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 			"commissionAsset": "USDT",
 			"id": 698759,
 			"maker": false,
+			"marginAsset": "USDT",
 			"orderId": 25851813,
 			"price": "7819.01",
 			"qty": "0.002",
@@ -190,21 +201,21 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 	symbol := "BTCUSDT"
 	startTime := int64(1569514978020)
 	endTime := int64(1569514978021)
-	fromID := int64(698759)
+	orderID := int64(25851813)
 	limit := 3
 	s.assertReq(func(r *request) {
 		e := newSignedRequest().setParams(params{
 			"symbol":    symbol,
+			"orderId":   orderID,
 			"startTime": startTime,
 			"endTime":   endTime,
-			"fromID":    fromID,
 			"limit":     limit,
 		})
 		s.assertRequestEqual(e, r)
 	})
 
-	trades, err := s.client.NewListAccountTradeService().Symbol(symbol).
-		StartTime(startTime).EndTime(endTime).FromID(fromID).Limit(limit).Do(newContext())
+	trades, err := s.client.NewListAccountTradeService().Symbol(symbol).OrderID(orderID).
+		StartTime(startTime).EndTime(endTime).Limit(limit).Do(newContext())
 	r := s.r()
 	r.NoError(err)
 	r.Len(trades, 1)
@@ -214,6 +225,7 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 		CommissionAsset: "USDT",
 		ID:              698759,
 		Maker:           false,
+		MarginAsset:     "USDT",
 		OrderID:         25851813,
 		Price:           "7819.01",
 		Quantity:        "0.002",
@@ -227,6 +239,46 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 	s.assertAccountTradeEqual(e, trades[0])
 }
 
+func (s *tradeServiceTestSuite) TestAccountTradeListInvalidParams() {
+	r := s.r()
+	_, err := s.client.NewListAccountTradeService().Symbol("BTCUSDT").
+		FromID(698759).StartTime(1569514978020).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewListAccountTradeService().Symbol("BTCUSDT").
+		StartTime(1569514978020).EndTime(1569514978020 + 8*24*3600*1000).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewListAccountTradeService().Symbol("BTCUSDT").
+		StartTime(1569514978021).EndTime(1569514978020).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewListAccountTradeService().OrderID(25851813).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewListAccountTradeService().Symbol("BTCUSDT").Limit(1001).Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+}
+
+func (s *tradeServiceTestSuite) TestTradesForOrder() {
+	data := []byte(`[{"id": 698759, "orderId": 25851813, "symbol": "BTCUSDT", "buyer": true, "maker": true}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":  "BTCUSDT",
+			"orderId": int64(25851813),
+			"limit":   1000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	trades, err := s.client.TradesForOrder(newContext(), "BTCUSDT", 25851813)
+	r := s.r()
+	r.NoError(err)
+	r.Len(trades, 1)
+	r.Equal(int64(25851813), trades[0].OrderID)
+	r.True(trades[0].Buyer)
+	r.True(trades[0].Maker)
+}
+
 func (s *tradeServiceTestSuite) assertAccountTradeEqual(e, a *AccountTrade) {
 	r := s.r()
 	r.Equal(e.ID, a.ID, "ID")
@@ -234,6 +286,7 @@ func (s *tradeServiceTestSuite) assertAccountTradeEqual(e, a *AccountTrade) {
 	r.Equal(e.Commission, a.Commission, "Commission")
 	r.Equal(e.CommissionAsset, a.CommissionAsset, "CommissionAsset")
 	r.Equal(e.Maker, a.Maker, "Maker")
+	r.Equal(e.MarginAsset, a.MarginAsset, "MarginAsset")
 	r.Equal(e.OrderID, a.OrderID, "OrderID")
 	r.Equal(e.Price, a.Price, "Price")
 	r.Equal(e.Quantity, a.Quantity, "Quantity")
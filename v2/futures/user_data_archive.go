@@ -0,0 +1,278 @@
+package futures
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+)
+
+const (
+	// archiveWindow is the longest time range accepted by the order and trade history endpoints, in ms
+	archiveWindow = int64(7 * 24 * time.Hour / time.Millisecond)
+	archiveLimit  = 1000
+)
+
+// GetUserDataEventArchive rebuild the user data events of the account between startTime and endTime
+// from the income, order and trade history, and send them in chronological order over the returned channel,
+// which is closed after the last event or when ctx is done.
+//
+// The traded symbols are found in the income history. Orders give ORDER_TRADE_UPDATE events for their
+// creation, cancelation and expiration, trades give TRADE events, and incomes other than commissions and
+// realized PnL give ACCOUNT_UPDATE events with the changed balance only.
+// The history is fetched before returning, the archive is limited to what the endpoints keep:
+// orders of the last 3 months, and canceled or expired orders without fill of the last 3 days.
+func GetUserDataEventArchive(ctx context.Context, client *Client, startTime, endTime time.Time) (<-chan *WsUserDataEvent, error) {
+	start := startTime.UnixNano() / int64(time.Millisecond)
+	end := endTime.UnixNano() / int64(time.Millisecond)
+
+	incomes, err := archiveIncomes(ctx, client, start, end)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*WsUserDataEvent, 0, len(incomes))
+	symbols := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, income := range incomes {
+		if income.Symbol != "" && !seen[income.Symbol] {
+			seen[income.Symbol] = true
+			symbols = append(symbols, income.Symbol)
+		}
+		if e := incomeEvent(income); e != nil {
+			events = append(events, e)
+		}
+	}
+	sort.Strings(symbols)
+	for _, symbol := range symbols {
+		orders, err := archiveOrders(ctx, client, symbol, start, end)
+		if err != nil {
+			return nil, err
+		}
+		trades, err := archiveTrades(ctx, client, symbol, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range orderTradeEvents(orders, trades) {
+			// orders of the archive can be canceled after it
+			if e.Time <= end {
+				events = append(events, e)
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time < events[j].Time
+	})
+
+	c := make(chan *WsUserDataEvent)
+	go func() {
+		defer close(c)
+		for _, e := range events {
+			select {
+			case c <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return c, nil
+}
+
+func archiveIncomes(ctx context.Context, client *Client, start, end int64) (res []*IncomeHistory, err error) {
+	// the incomes of the last millisecond of a page are requested again, funding fees of several symbols
+	// settle on the same millisecond, the repeated ones are skipped by tranId and type
+	type incomeKey struct {
+		tranID     int64
+		incomeType string
+	}
+	var boundary map[incomeKey]bool
+	for {
+		page, err := client.NewGetIncomeHistoryService().StartTime(start).EndTime(end).Limit(archiveLimit).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, income := range page {
+			if !boundary[incomeKey{income.TranID, income.IncomeType}] {
+				res = append(res, income)
+			}
+		}
+		if len(page) < archiveLimit {
+			return res, nil
+		}
+		last := page[len(page)-1].Time
+		boundary = make(map[incomeKey]bool)
+		if page[0].Time == last {
+			// a whole page on one millisecond would be returned again
+			start = last + 1
+			continue
+		}
+		start = last
+		for _, income := range page {
+			if income.Time == last {
+				boundary[incomeKey{income.TranID, income.IncomeType}] = true
+			}
+		}
+	}
+}
+
+func archiveOrders(ctx context.Context, client *Client, symbol string, start, end int64) (res []*Order, err error) {
+	for from := start; from <= end; from += archiveWindow {
+		to := from + archiveWindow - 1
+		if to > end {
+			to = end
+		}
+		s := client.NewListOrdersService().Symbol(symbol).StartTime(from).EndTime(to).Limit(archiveLimit)
+		for {
+			orders, err := s.Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			page, more := s.NextPage(orders)
+			res = append(res, page...)
+			if !more {
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func archiveTrades(ctx context.Context, client *Client, symbol string, start, end int64) (res []*AccountTrade, err error) {
+	for from := start; from <= end; from += archiveWindow {
+		to := from + archiveWindow - 1
+		if to > end {
+			to = end
+		}
+		s := client.NewListAccountTradeService().Symbol(symbol).StartTime(from).EndTime(to).Limit(archiveLimit)
+		for {
+			page, err := s.Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			done := len(page) < archiveLimit
+			for _, t := range page {
+				if t.Time > to {
+					done = true
+					break
+				}
+				res = append(res, t)
+			}
+			if done {
+				break
+			}
+			// the trades of a millisecond can fill a page, the next pages follow the trade ids
+			s = client.NewListAccountTradeService().Symbol(symbol).FromID(page[len(page)-1].ID + 1).Limit(archiveLimit)
+		}
+	}
+	return res, nil
+}
+
+// incomeEvent return the account update of an income, nil for the incomes of a trade
+func incomeEvent(income *IncomeHistory) *WsUserDataEvent {
+	var reason UserDataEventReasonType
+	switch income.IncomeType {
+	case "COMMISSION", "REALIZED_PNL":
+		return nil
+	case "TRANSFER":
+		reason = UserDataEventReasonTypeDeposit
+		if len(income.Income) > 0 && income.Income[0] == '-' {
+			reason = UserDataEventReasonTypeWithdraw
+		}
+	case "INSURANCE_CLEAR":
+		reason = UserDataEventReasonTypeInsuranceClear
+	case "FUNDING_FEE":
+		reason = UserDataEventReasonTypeFundingFee
+	default:
+		reason = UserDataEventReasonType(income.IncomeType)
+	}
+	return &WsUserDataEvent{
+		Event:           UserDataEventTypeAccountUpdate,
+		Time:            income.Time,
+		TransactionTime: income.Time,
+		AccountUpdate: WsAccountUpdate{
+			Reason:   reason,
+			Balances: []WsBalance{{Asset: income.Asset, ChangeBalance: income.Income}},
+		},
+	}
+}
+
+// orderTradeEvents return the creation, trade, cancelation and expiration events of orders
+func orderTradeEvents(orders []*Order, trades []*AccountTrade) []*WsUserDataEvent {
+	events := make([]*WsUserDataEvent, 0, len(orders)+len(trades))
+	byID := make(map[int64]*Order, len(orders))
+	for _, o := range orders {
+		byID[o.OrderID] = o
+		events = append(events, orderEvent(o, o.Time, OrderExecutionTypeNew, OrderStatusTypeNew, "0"))
+		switch o.Status {
+		case OrderStatusTypeCanceled:
+			events = append(events, orderEvent(o, o.UpdateTime, OrderExecutionTypeCanceled, o.Status, o.ExecutedQuantity))
+		case OrderStatusTypeExpired:
+			events = append(events, orderEvent(o, o.UpdateTime, OrderExecutionTypeExpired, o.Status, o.ExecutedQuantity))
+		}
+	}
+
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].ID < trades[j].ID
+	})
+	filled := make(map[int64]*big.Rat)
+	for _, t := range trades {
+		o, ok := byID[t.OrderID]
+		if !ok {
+			// the order was created before the archive
+			o = &Order{Symbol: t.Symbol, OrderID: t.OrderID, Side: t.Side, PositionSide: t.PositionSide}
+		}
+		acc, ok := filled[t.OrderID]
+		if !ok {
+			acc = new(big.Rat)
+			filled[t.OrderID] = acc
+		}
+		if q, ok := new(big.Rat).SetString(t.Quantity); ok {
+			acc.Add(acc, q)
+		}
+		status := OrderStatusTypePartiallyFilled
+		if orig, ok := new(big.Rat).SetString(o.OrigQuantity); ok && acc.Cmp(orig) >= 0 {
+			status = OrderStatusTypeFilled
+		}
+		e := orderEvent(o, t.Time, OrderExecutionTypeTrade, status, acc.FloatString(8))
+		u := &e.OrderTradeUpdate
+		u.LastFilledQty = t.Quantity
+		u.LastFilledPrice = t.Price
+		u.Commission = t.Commission
+		u.CommissionAsset = t.CommissionAsset
+		u.TradeID = t.ID
+		u.IsMaker = t.Maker
+		u.RealizedPnL = t.RealizedPnl
+		events = append(events, e)
+	}
+	return events
+}
+
+func orderEvent(o *Order, t int64, executionType OrderExecutionType, status OrderStatusType, filledQty string) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event:           UserDataEventTypeOrderTradeUpdate,
+		Time:            t,
+		TransactionTime: t,
+		OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol:               o.Symbol,
+			ClientOrderID:        o.ClientOrderID,
+			Side:                 o.Side,
+			Type:                 o.Type,
+			TimeInForce:          o.TimeInForce,
+			OriginalQty:          o.OrigQuantity,
+			OriginalPrice:        o.Price,
+			AveragePrice:         o.AvgPrice,
+			StopPrice:            o.StopPrice,
+			ExecutionType:        executionType,
+			Status:               status,
+			ID:                   o.OrderID,
+			AccumulatedFilledQty: filledQty,
+			TradeTime:            t,
+			IsReduceOnly:         o.ReduceOnly,
+			WorkingType:          o.WorkingType,
+			OriginalType:         OrderType(o.OrigType),
+			PositionSide:         o.PositionSide,
+			IsClosingPosition:    o.ClosePosition,
+			ActivationPrice:      o.ActivatePrice,
+			CallbackRate:         o.PriceRate,
+		},
+	}
+}
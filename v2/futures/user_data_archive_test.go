@@ -0,0 +1,161 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserDataEventArchive(t *testing.T) {
+	r := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/fapi/v1/income":
+			w.Write([]byte(`[
+				{"symbol": "", "incomeType": "TRANSFER", "income": "100", "asset": "USDT", "time": 1000, "tranId": 1},
+				{"symbol": "BTCUSDT", "incomeType": "COMMISSION", "income": "-0.01", "asset": "USDT", "time": 3000, "tranId": 2},
+				{"symbol": "BTCUSDT", "incomeType": "FUNDING_FEE", "income": "-0.5", "asset": "USDT", "time": 4000, "tranId": 3}
+			]`))
+		case "/fapi/v1/allOrders":
+			r.Equal("BTCUSDT", req.URL.Query().Get("symbol"))
+			w.Write([]byte(`[
+				{"symbol": "BTCUSDT", "orderId": 10, "clientOrderId": "a", "price": "100", "origQty": "2", "executedQty": "2",
+				 "status": "FILLED", "type": "LIMIT", "side": "BUY", "time": 2000, "updateTime": 3500},
+				{"symbol": "BTCUSDT", "orderId": 11, "clientOrderId": "b", "price": "90", "origQty": "1", "executedQty": "0",
+				 "status": "CANCELED", "type": "LIMIT", "side": "BUY", "time": 2500, "updateTime": 5000},
+				{"symbol": "BTCUSDT", "orderId": 12, "clientOrderId": "c", "price": "80", "origQty": "1", "executedQty": "0",
+				 "status": "CANCELED", "type": "LIMIT", "side": "BUY", "time": 2600, "updateTime": 99000}
+			]`))
+		case "/fapi/v1/userTrades":
+			w.Write([]byte(`[
+				{"symbol": "BTCUSDT", "id": 101, "orderId": 10, "side": "BUY", "price": "100", "qty": "1.5",
+				 "commission": "0.01", "commissionAsset": "USDT", "realizedPnl": "0", "maker": true, "time": 3000},
+				{"symbol": "BTCUSDT", "id": 102, "orderId": 10, "side": "BUY", "price": "100", "qty": "0.5",
+				 "commission": "0.01", "commissionAsset": "USDT", "realizedPnl": "0", "maker": false, "time": 3500}
+			]`))
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	}))
+	defer server.Close()
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	events, err := GetUserDataEventArchive(context.Background(), c, time.Unix(0, 0), time.Unix(10, 0))
+	r.NoError(err)
+
+	type summary struct {
+		time   int64
+		event  UserDataEventType
+		detail string
+	}
+	var got []summary
+	for e := range events {
+		s := summary{time: e.Time, event: e.Event}
+		if e.Event == UserDataEventTypeAccountUpdate {
+			s.detail = string(e.AccountUpdate.Reason) + " " + e.AccountUpdate.Balances[0].ChangeBalance
+		} else {
+			u := e.OrderTradeUpdate
+			s.detail = u.ClientOrderID + " " + string(u.ExecutionType) + " " + string(u.Status) + " " + u.AccumulatedFilledQty
+		}
+		got = append(got, s)
+	}
+	r.Equal([]summary{
+		{1000, UserDataEventTypeAccountUpdate, "DEPOSIT 100"},
+		{2000, UserDataEventTypeOrderTradeUpdate, "a NEW NEW 0"},
+		{2500, UserDataEventTypeOrderTradeUpdate, "b NEW NEW 0"},
+		{2600, UserDataEventTypeOrderTradeUpdate, "c NEW NEW 0"},
+		{3000, UserDataEventTypeOrderTradeUpdate, "a TRADE PARTIALLY_FILLED 1.50000000"},
+		{3500, UserDataEventTypeOrderTradeUpdate, "a TRADE FILLED 2.00000000"},
+		{4000, UserDataEventTypeAccountUpdate, "FUNDING_FEE -0.5"},
+		{5000, UserDataEventTypeOrderTradeUpdate, "b CANCELED CANCELED 0"},
+	}, got)
+}
+
+func TestGetUserDataEventArchiveError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code": -2015, "msg": "Invalid API-key, IP, or permissions for action."}`))
+	}))
+	defer server.Close()
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	_, err := GetUserDataEventArchive(context.Background(), c, time.Unix(0, 0), time.Unix(10, 0))
+	require.Error(t, err)
+}
+
+func TestArchiveIncomesBoundary(t *testing.T) {
+	r := require.New(t)
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := req.URL.Query().Get("startTime")
+		starts = append(starts, start)
+		var incomes []*IncomeHistory
+		switch start {
+		case "0":
+			// a full page ending with two of the three funding fees of 5000
+			for i := int64(1); i <= archiveLimit-2; i++ {
+				incomes = append(incomes, &IncomeHistory{IncomeType: "TRANSFER", Time: i, TranID: i})
+			}
+			incomes = append(incomes,
+				&IncomeHistory{Symbol: "BTCUSDT", IncomeType: "FUNDING_FEE", Time: 5000, TranID: 5001},
+				&IncomeHistory{Symbol: "ETHUSDT", IncomeType: "FUNDING_FEE", Time: 5000, TranID: 5002})
+		case "5000":
+			incomes = append(incomes,
+				&IncomeHistory{Symbol: "BTCUSDT", IncomeType: "FUNDING_FEE", Time: 5000, TranID: 5001},
+				&IncomeHistory{Symbol: "ETHUSDT", IncomeType: "FUNDING_FEE", Time: 5000, TranID: 5002},
+				&IncomeHistory{Symbol: "BNBUSDT", IncomeType: "FUNDING_FEE", Time: 5000, TranID: 5003})
+		}
+		data, _ := json.Marshal(incomes)
+		w.Write(data)
+	}))
+	defer server.Close()
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	incomes, err := archiveIncomes(context.Background(), c, 0, 10000)
+	r.NoError(err)
+	r.Equal([]string{"0", "5000"}, starts)
+	r.Len(incomes, archiveLimit+1)
+	r.Equal(int64(5003), incomes[len(incomes)-1].TranID)
+}
+
+func TestArchiveTradesSameMillisecond(t *testing.T) {
+	r := require.New(t)
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		var trades []*AccountTrade
+		if q.Get("fromId") == "" {
+			queries = append(queries, "startTime="+q.Get("startTime"))
+			// more trades than a page share the millisecond 2000
+			for id := int64(1); id <= archiveLimit; id++ {
+				trades = append(trades, &AccountTrade{Symbol: "BTCUSDT", ID: id, Time: 2000})
+			}
+		} else {
+			queries = append(queries, "fromId="+q.Get("fromId"))
+			r.Empty(q.Get("startTime"))
+			trades = append(trades,
+				&AccountTrade{Symbol: "BTCUSDT", ID: archiveLimit + 1, Time: 2000},
+				&AccountTrade{Symbol: "BTCUSDT", ID: archiveLimit + 2, Time: 9000})
+		}
+		data, _ := json.Marshal(trades)
+		w.Write(data)
+	}))
+	defer server.Close()
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	trades, err := archiveTrades(context.Background(), c, "BTCUSDT", 0, 3000)
+	r.NoError(err)
+	r.Equal([]string{"startTime=0", "fromId=1001"}, queries)
+	// the trade after the end of the archive is left out
+	r.Len(trades, archiveLimit+1)
+	r.Equal(int64(archiveLimit+1), trades[len(trades)-1].ID)
+}
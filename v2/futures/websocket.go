@@ -5,8 +5,34 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 )
 
+// WsLogger receives the websocket lifecycle events (connect, disconnect, stall), listen keys are redacted
+var WsLogger common.Logger = common.NopLogger
+
+// WsHook receives the websocket stream metrics (messages, bytes, handler latency, connects), nil disables it
+var WsHook common.WsHook
+
+// WebsocketBufferSize is the number of messages queued between the read loop of a stream and its
+// handler, so that a slow handler does not stall the connection. Zero, the default, calls the
+// handler synchronously from the read loop.
+var WebsocketBufferSize = 0
+
+// WebsocketOverflowPolicy is what happens to a message read while the buffer is full,
+// every dropped message is reported to the error handler as a *common.WsOverflowError
+var WebsocketOverflowPolicy = common.OverflowBlock
+
+// WebsocketWorkerPool run the handler of a stream concurrently on a pool of workers when it is not nil,
+// keeping the messages of a same key, the symbol of a combined stream by default, in order.
+// Handler panics are recovered and reported to the error handler as a *common.WsHandlerPanicError.
+var WebsocketWorkerPool *common.WsWorkerPoolConfig
+
+// WebsocketMaxMessageSize is the default read limit in bytes of the websocket connections, a message
+// exceeding it closes the connection and is reported to the error handler as a *common.WsMessageTooBigError
+var WebsocketMaxMessageSize int64 = 655350
+
 // WsHandler handle raw websocket message
 type WsHandler func(message []byte)
 
@@ -16,14 +42,79 @@ type ErrHandler func(err error)
 // WsConfig webservice configuration
 type WsConfig struct {
 	Endpoint string
+	// MaxMessageSize is the read limit in bytes of the connection
+	MaxMessageSize int64
+	// Keepalive enables sending ping messages every KeepaliveTimeout, the connection is closed
+	// when no pong is received within KeepaliveTimeout
+	Keepalive        bool
+	KeepaliveTimeout time.Duration
+	// PingHandler replaces the default handler of the ping messages of the server, which replies with a pong
+	PingHandler func(data string) error
+	// PongHandler is called with the pong messages of the server
+	PongHandler func(data string) error
+	// BytesReceived counts the bytes of the messages read on the connection when it is not nil
+	BytesReceived *common.WsByteCounter
+}
+
+// WsConfigOption define an option of a websocket connection, every Ws*Serve function accepts them
+type WsConfigOption func(cfg *WsConfig)
+
+// WithMaxMessageSize set the read limit in bytes of the connection, WebsocketMaxMessageSize by default
+func WithMaxMessageSize(size int64) WsConfigOption {
+	return func(cfg *WsConfig) {
+		cfg.MaxMessageSize = size
+	}
+}
+
+// WithKeepalive enable or disable the keepalive of the connection, WebsocketKeepalive by default
+func WithKeepalive(enabled bool) WsConfigOption {
+	return func(cfg *WsConfig) {
+		cfg.Keepalive = enabled
+	}
+}
+
+// WithKeepaliveTimeout set the keepalive interval of the connection, WebsocketTimeout by default
+func WithKeepaliveTimeout(d time.Duration) WsConfigOption {
+	return func(cfg *WsConfig) {
+		cfg.KeepaliveTimeout = d
+	}
+}
+
+// WithPingHandler set the handler of the ping messages of the server, h must send the pong itself
+func WithPingHandler(h func(data string) error) WsConfigOption {
+	return func(cfg *WsConfig) {
+		cfg.PingHandler = h
+	}
 }
 
-func newWsConfig(endpoint string) *WsConfig {
-	return &WsConfig{
-		Endpoint: endpoint,
+// WithPongHandler set the handler of the pong messages of the server
+func WithPongHandler(h func(data string) error) WsConfigOption {
+	return func(cfg *WsConfig) {
+		cfg.PongHandler = h
 	}
 }
 
+// WithBytesReceived count the bytes of the messages read on the connection in counter,
+// so that the read limits can be sized from real data
+func WithBytesReceived(counter *common.WsByteCounter) WsConfigOption {
+	return func(cfg *WsConfig) {
+		cfg.BytesReceived = counter
+	}
+}
+
+func newWsConfig(endpoint string, opts ...WsConfigOption) *WsConfig {
+	cfg := &WsConfig{
+		Endpoint:         endpoint,
+		MaxMessageSize:   WebsocketMaxMessageSize,
+		Keepalive:        WebsocketKeepalive,
+		KeepaliveTimeout: WebsocketTimeout,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
 var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	Dialer := websocket.Dialer{
 		Proxy:             http.ProxyFromEnvironment,
@@ -31,11 +122,17 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 		EnableCompression: false,
 	}
 
+	endpoint := common.RedactWsEndpoint(cfg.Endpoint)
 	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
 	if err != nil {
+		WsLogger.Error("ws connect failed", "endpoint", endpoint, "error", err)
 		return nil, nil, err
 	}
-	c.SetReadLimit(655350)
+	WsLogger.Info("ws connected", "endpoint", endpoint)
+	if WsHook != nil {
+		WsHook.OnConnect(endpoint)
+	}
+	c.SetReadLimit(cfg.MaxMessageSize)
 	doneC = make(chan struct{})
 	stopC = make(chan struct{})
 	go func() {
@@ -43,12 +140,40 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 		// websocket.Conn.ReadMessage or when the stopC channel is
 		// closed by the client.
 		defer close(doneC)
-		if WebsocketKeepalive {
-			keepAlive(c, WebsocketTimeout)
+		if cfg.PingHandler != nil {
+			c.SetPingHandler(cfg.PingHandler)
+		}
+		if cfg.Keepalive {
+			keepAlive(c, cfg.KeepaliveTimeout, endpoint, cfg.PongHandler)
+		} else if cfg.PongHandler != nil {
+			c.SetPongHandler(cfg.PongHandler)
 		}
 		// Wait for the stopC channel to be closed.  We do that in a
 		// separate goroutine because ReadMessage is a blocking
 		// operation.
+		dispatch := func(message []byte) {
+			if WsHook == nil {
+				handler(message)
+				return
+			}
+			start := time.Now()
+			handler(message)
+			WsHook.OnMessage(endpoint, len(message), time.Since(start))
+		}
+		if WebsocketWorkerPool != nil {
+			pool := common.NewWsWorkerPool(*WebsocketWorkerPool, dispatch, func(err *common.WsHandlerPanicError) {
+				errHandler(err)
+			})
+			defer pool.Close()
+			dispatch = pool.Push
+		}
+		if WebsocketBufferSize > 0 {
+			buffer := common.NewWsBuffer(WebsocketBufferSize, WebsocketOverflowPolicy, dispatch, func(dropped uint64) {
+				errHandler(&common.WsOverflowError{Endpoint: endpoint, Dropped: dropped})
+			})
+			defer buffer.Close()
+			dispatch = buffer.Push
+		}
 		silent := false
 		go func() {
 			select {
@@ -61,23 +186,36 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 		for {
 			_, message, err := c.ReadMessage()
 			if err != nil {
+				err = common.NewWsReadError(endpoint, cfg.MaxMessageSize, err)
 				if !silent {
+					WsLogger.Error("ws disconnected", "endpoint", endpoint, "error", err)
 					errHandler(err)
+				} else {
+					WsLogger.Info("ws stopped", "endpoint", endpoint)
+				}
+				if WsHook != nil {
+					WsHook.OnDisconnect(endpoint, err)
 				}
 				return
 			}
-			handler(message)
+			if cfg.BytesReceived != nil {
+				cfg.BytesReceived.Add(len(message))
+			}
+			dispatch(message)
 		}
 	}()
 	return
 }
 
-func keepAlive(c *websocket.Conn, timeout time.Duration) {
+func keepAlive(c *websocket.Conn, timeout time.Duration, endpoint string, pongHandler func(data string) error) {
 	ticker := time.NewTicker(timeout)
 
 	lastResponse := time.Now()
 	c.SetPongHandler(func(msg string) error {
 		lastResponse = time.Now()
+		if pongHandler != nil {
+			return pongHandler(msg)
+		}
 		return nil
 	})
 
@@ -91,6 +229,7 @@ func keepAlive(c *websocket.Conn, timeout time.Duration) {
 			}
 			<-ticker.C
 			if time.Since(lastResponse) > timeout {
+				WsLogger.Error("ws stalled", "endpoint", endpoint, "lastPong", lastResponse)
 				c.Close()
 				return
 			}
@@ -1128,6 +1128,63 @@ func (s *websocketServiceTestSuite) TestAllLiquidationOrderServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestCombinedForceOrderServe() {
+	data := []byte(`{
+		"stream":"btcusdt@forceOrder",
+		"data":{
+			"e":"forceOrder",
+			"E":1568014460893,
+			"o":{
+				"s":"BTCUSDT",
+				"S":"SELL",
+				"o":"LIMIT",
+				"f":"IOC",
+				"q":"0.014",
+				"p":"9910",
+				"ap":"9910",
+				"X":"FILLED",
+				"l":"0.014",
+				"z":"0.014",
+				"T":1568014460893
+			}
+		}
+	}`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsCombinedForceOrderServe([]string{"BTCUSDT", "ETHUSDT"}, func(event *WsLiquidationOrderEvent) {
+		e := &WsLiquidationOrderEvent{
+			Event: "forceOrder",
+			Time:  1568014460893,
+			LiquidationOrder: WsLiquidationOrder{
+				Symbol:               "BTCUSDT",
+				Side:                 SideTypeSell,
+				OrderType:            OrderTypeLimit,
+				TimeInForce:          TimeInForceTypeIOC,
+				OrigQuantity:         "0.014",
+				Price:                "9910",
+				AvgPrice:             "9910",
+				OrderStatus:          OrderStatusTypeFilled,
+				LastFilledQty:        "0.014",
+				AccumulatedFilledQty: "0.014",
+				TradeTime:            1568014460893,
+			},
+		}
+		s.assertLiquidationOrderEvent(e, event)
+	},
+		func(err error) {
+			s.r().EqualError(err, fakeErrMsg)
+		})
+
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	_, _, err = WsCombinedForceOrderServe(nil, nil, nil)
+	s.r().ErrorIs(err, ErrNoStreams)
+}
+
 func (s *websocketServiceTestSuite) assertLiquidationOrderEvent(e, a *WsLiquidationOrderEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -1847,6 +1904,85 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdateGTD() {
+	data := []byte(`{
+		"e":"ORDER_TRADE_UPDATE",
+		"E":1696569200315,
+		"T":1696569200313,
+		"o":{
+		  "s":"BTCUSDT",
+		  "c":"gtd_queue_5",
+		  "S":"BUY",
+		  "o":"LIMIT",
+		  "f":"GTD",
+		  "q":"0.002",
+		  "p":"27500",
+		  "ap":"0",
+		  "sp":"0",
+		  "x":"NEW",
+		  "X":"NEW",
+		  "i":3552184374,
+		  "l":"0",
+		  "z":"0",
+		  "L":"0",
+		  "n":"0",
+		  "N":"USDT",
+		  "T":1696569200313,
+		  "t":0,
+		  "b":"55",
+		  "a":"0",
+		  "m":false,
+		  "R":false,
+		  "wt":"CONTRACT_PRICE",
+		  "ot":"LIMIT",
+		  "ps":"BOTH",
+		  "cp":false,
+		  "rp":"0",
+		  "pP":false,
+		  "si":0,
+		  "ss":0,
+		  "V":"EXPIRE_TAKER",
+		  "pm":"QUEUE_5",
+		  "gtd":1696572800000
+		}
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "ORDER_TRADE_UPDATE",
+		Time:            1696569200315,
+		TransactionTime: 1696569200313,
+		OrderTradeUpdate: WsOrderUpdate{
+			Symbol:               "BTCUSDT",
+			ClientOrderID:        "gtd_queue_5",
+			Side:                 SideTypeBuy,
+			Type:                 OrderTypeLimit,
+			TimeInForce:          TimeInForceTypeGTD,
+			OriginalQty:          "0.002",
+			OriginalPrice:        "27500",
+			AveragePrice:         "0",
+			StopPrice:            "0",
+			ExecutionType:        "NEW",
+			Status:               "NEW",
+			ID:                   3552184374,
+			LastFilledQty:        "0",
+			AccumulatedFilledQty: "0",
+			LastFilledPrice:      "0",
+			CommissionAsset:      "USDT",
+			Commission:           "0",
+			TradeTime:            1696569200313,
+			BidsNotional:         "55",
+			AsksNotional:         "0",
+			WorkingType:          WorkingTypeContractPrice,
+			OriginalType:         OrderTypeLimit,
+			PositionSide:         PositionSideTypeBoth,
+			RealizedPnL:          "0",
+			SelfTradePrevention:  STPModeExpireTaker,
+			PriceMatch:           PriceMatchTypeQueue5,
+			GoodTillDate:         1696572800000,
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketServiceTestSuite) TestWsUserDataServeAccountConfigUpdate() {
 	data := []byte(`{
 		"e":"ACCOUNT_CONFIG_UPDATE",
@@ -1945,6 +2081,11 @@ func (s *websocketServiceTestSuite) assertOrderTradeUpdate(e, a WsOrderTradeUpda
 	r.Equal(e.ActivationPrice, a.ActivationPrice, "ActivationPrice")
 	r.Equal(e.CallbackRate, a.CallbackRate, "CallbackRate")
 	r.Equal(e.RealizedPnL, a.RealizedPnL, "RealizedPnL")
+	r.Equal(e.StrategyID, a.StrategyID, "StrategyID")
+	r.Equal(e.StrategySubID, a.StrategySubID, "StrategySubID")
+	r.Equal(e.SelfTradePrevention, a.SelfTradePrevention, "SelfTradePrevention")
+	r.Equal(e.PriceMatch, a.PriceMatch, "PriceMatch")
+	r.Equal(e.GoodTillDate, a.GoodTillDate, "GoodTillDate")
 }
 
 func (s *websocketServiceTestSuite) assertAccountConfigUpdate(e, a WsAccountConfigUpdate) {
@@ -1952,3 +2093,79 @@ func (s *websocketServiceTestSuite) assertAccountConfigUpdate(e, a WsAccountConf
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
 	r.Equal(e.Leverage, a.Leverage, "Leverage")
 }
+
+func (s *websocketServiceTestSuite) TestCombinedServeInvalidStreams() {
+	s.mockWsServe(nil, nil)
+	defer s.assertWsServe(0)
+	noop := func(err error) {}
+	serves := map[string]func(symbols []string) error{
+		"AggTrade": func(symbols []string) error {
+			_, _, err := WsCombinedAggTradeServe(symbols, func(*WsAggTradeEvent) {}, noop)
+			return err
+		},
+		"MarkPrice": func(symbols []string) error {
+			_, _, err := WsCombinedMarkPriceServe(symbols, func(*WsMarkPriceEvent) {}, noop)
+			return err
+		},
+		"MarkPriceWithRate": func(symbols []string) error {
+			rates := make(map[string]time.Duration, len(symbols))
+			for _, symbol := range symbols {
+				rates[symbol] = time.Second
+			}
+			_, _, err := WsCombinedMarkPriceServeWithRate(rates, func(*WsMarkPriceEvent) {}, noop)
+			return err
+		},
+		"Kline": func(symbols []string) error {
+			intervals := make(map[string]string, len(symbols))
+			for _, symbol := range symbols {
+				intervals[symbol] = "1m"
+			}
+			_, _, err := WsCombinedKlineServe(intervals, func(*WsKlineEvent) {}, noop)
+			return err
+		},
+		"ContinuousKline": func(symbols []string) error {
+			args := make([]*WsContinuousKlineSubcribeArgs, len(symbols))
+			for i, symbol := range symbols {
+				args[i] = &WsContinuousKlineSubcribeArgs{Pair: symbol, ContractType: "PERPETUAL", Interval: "1m"}
+			}
+			_, _, err := WsCombinedContinuousKlineServe(args, func(*WsContinuousKlineEvent) {}, noop)
+			return err
+		},
+		"Depth": func(symbols []string) error {
+			levels := make(map[string]string, len(symbols))
+			for _, symbol := range symbols {
+				levels[symbol] = "5"
+			}
+			_, _, err := WsCombinedDepthServe(levels, func(*WsDepthEvent) {}, noop)
+			return err
+		},
+		"DiffDepth": func(symbols []string) error {
+			_, _, err := WsCombinedDiffDepthServe(symbols, func(*WsDepthEvent) {}, noop)
+			return err
+		},
+	}
+	for name, serve := range serves {
+		s.Run(name, func() {
+			r := s.r()
+			r.ErrorIs(serve(nil), ErrNoStreams)
+			r.ErrorIs(serve([]string{}), ErrNoStreams)
+			r.EqualError(serve([]string{"BTCUSDT", ""}), `invalid stream symbol ""`)
+			r.EqualError(serve([]string{"BTC/USDT"}), `invalid stream symbol "BTC/USDT"`)
+			r.EqualError(serve([]string{"btcusdt@depth"}), `invalid stream symbol "btcusdt@depth"`)
+		})
+	}
+}
+
+func (s *websocketServiceTestSuite) TestWsServeOptions() {
+	var got *WsConfig
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		got = cfg
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	_, _, err := WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {},
+		WithKeepalive(true), WithKeepaliveTimeout(time.Second), WithMaxMessageSize(1024))
+	s.r().NoError(err)
+	s.r().True(got.Keepalive)
+	s.r().Equal(time.Second, got.KeepaliveTimeout)
+	s.r().Equal(int64(1024), got.MaxMessageSize)
+}
@@ -1,9 +1,13 @@
 package binance
 
 import (
+	"bytes"
 	"context"
+	stdjson "encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // KlinesService list klines
@@ -46,8 +50,7 @@ func (s *KlinesService) EndTime(endTime int64) *KlinesService {
 	return s
 }
 
-// Do send request
-func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+func (s *KlinesService) klines(ctx context.Context, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/klines",
@@ -63,10 +66,19 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	if s.endTime != nil {
 		r.setParam("endTime", *s.endTime)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return s.c.callAPI(ctx, r, opts...)
+}
+
+// Do send request
+func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	data, err := s.klines(ctx, opts...)
 	if err != nil {
 		return []*Kline{}, err
 	}
+	return parseKlines(data)
+}
+
+func parseKlines(data []byte) (res []*Kline, err error) {
 	j, err := newJSON(data)
 	if err != nil {
 		return []*Kline{}, err
@@ -110,3 +122,96 @@ type Kline struct {
 	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
 	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
 }
+
+// DoTyped send request like Do, but return the klines with their numbers and times parsed.
+// An error is returned for the first field which cannot be parsed.
+func (s *KlinesService) DoTyped(ctx context.Context, opts ...RequestOption) (res []*KlineTyped, err error) {
+	data, err := s.klines(ctx, opts...)
+	if err != nil {
+		return []*KlineTyped{}, err
+	}
+	return parseKlinesTyped(data)
+}
+
+// KlineTyped define kline info with parsed numbers and times, see Kline
+type KlineTyped struct {
+	OpenTime                 time.Time
+	Open                     float64
+	High                     float64
+	Low                      float64
+	Close                    float64
+	Volume                   float64
+	CloseTime                time.Time
+	QuoteAssetVolume         float64
+	TradeNum                 int64
+	TakerBuyBaseAssetVolume  float64
+	TakerBuyQuoteAssetVolume float64
+}
+
+func parseKlinesTyped(data []byte) ([]*KlineTyped, error) {
+	rows := make([][]stdjson.RawMessage, 0)
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return []*KlineTyped{}, err
+	}
+	res := make([]*KlineTyped, len(rows))
+	for i, row := range rows {
+		if len(row) < 11 {
+			return []*KlineTyped{}, fmt.Errorf("invalid kline response: kline %d has %d fields", i, len(row))
+		}
+		k := new(KlineTyped)
+		p := klineFieldParser{row: row}
+		k.OpenTime = p.time(0)
+		k.Open = p.float(1)
+		k.High = p.float(2)
+		k.Low = p.float(3)
+		k.Close = p.float(4)
+		k.Volume = p.float(5)
+		k.CloseTime = p.time(6)
+		k.QuoteAssetVolume = p.float(7)
+		k.TradeNum = p.int(8)
+		k.TakerBuyBaseAssetVolume = p.float(9)
+		k.TakerBuyQuoteAssetVolume = p.float(10)
+		if p.err != nil {
+			return []*KlineTyped{}, fmt.Errorf("invalid kline response: kline %d: %w", i, p.err)
+		}
+		res[i] = k
+	}
+	return res, nil
+}
+
+// klineFieldParser parse the fields of a kline row, keeping the first error
+type klineFieldParser struct {
+	row []stdjson.RawMessage
+	err error
+}
+
+// field return the field at index without the quotes of a string
+func (p *klineFieldParser) field(index int) string {
+	return string(bytes.Trim(p.row[index], `"`))
+}
+
+func (p *klineFieldParser) float(index int) float64 {
+	if p.err != nil {
+		return 0
+	}
+	v, err := strconv.ParseFloat(p.field(index), 64)
+	if err != nil {
+		p.err = fmt.Errorf("field %d: %w", index, err)
+	}
+	return v
+}
+
+func (p *klineFieldParser) int(index int) int64 {
+	if p.err != nil {
+		return 0
+	}
+	v, err := strconv.ParseInt(p.field(index), 10, 64)
+	if err != nil {
+		p.err = fmt.Errorf("field %d: %w", index, err)
+	}
+	return v
+}
+
+func (p *klineFieldParser) time(index int) time.Time {
+	return time.UnixMilli(p.int(index))
+}
@@ -1,7 +1,11 @@
 package binance
 
 import (
+	"bytes"
+	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -112,3 +116,97 @@ func (s *klineServiceTestSuite) assertKlineEqual(e, a *Kline) {
 	r.Equal(e.TakerBuyBaseAssetVolume, a.TakerBuyBaseAssetVolume, "TakerBuyBaseAssetVolume")
 	r.Equal(e.TakerBuyQuoteAssetVolume, a.TakerBuyQuoteAssetVolume, "TakerBuyQuoteAssetVolume")
 }
+
+func (s *klineServiceTestSuite) TestKlinesTyped() {
+	data := []byte(`[
+        [
+            1499040000000,
+            "0.01634790",
+            "0.80000000",
+            "0.01575800",
+            "0.01577100",
+            "1.5E-7",
+            1499644799999,
+            "2434.19055334",
+            308,
+            "1.2e+3",
+            "28.46694368",
+            "0"
+        ]
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":   "LTCBTC",
+			"interval": "15m",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	klines, err := s.client.NewKlinesService().Symbol("LTCBTC").Interval("15m").DoTyped(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(klines, 1)
+	r.Equal(&KlineTyped{
+		OpenTime:                 time.UnixMilli(1499040000000),
+		Open:                     0.0163479,
+		High:                     0.8,
+		Low:                      0.015758,
+		Close:                    0.015771,
+		Volume:                   1.5e-7,
+		CloseTime:                time.UnixMilli(1499644799999),
+		QuoteAssetVolume:         2434.19055334,
+		TradeNum:                 308,
+		TakerBuyBaseAssetVolume:  1200,
+		TakerBuyQuoteAssetVolume: 28.46694368,
+	}, klines[0])
+}
+
+func (s *klineServiceTestSuite) TestKlinesTypedInvalidField() {
+	data := []byte(`[
+        [1499040000000, "0.1", "0.2", "0.1", "0.2", "10", 1499644799999, "1", 3, "5", "0.5", "0"],
+        [1499040000001, "0.1", "0.2", "0.1", "bad", "10", 1499644799999, "1", 3, "5", "0.5", "0"]
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	_, err := s.client.NewKlinesService().Symbol("LTCBTC").Interval("15m").DoTyped(newContext())
+	r := s.r()
+	r.ErrorIs(err, strconv.ErrSyntax)
+	r.Contains(err.Error(), "kline 1: field 4")
+}
+
+func klinesFixture(n int) []byte {
+	var b bytes.Buffer
+	b.WriteString("[")
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `[%d,"0.01634790","0.80000000","0.01575800","0.01577100","148976.11427815",%d,"2434.19055334",308,"1756.87402397","28.46694368","0"]`,
+			1499040000000+int64(i)*60000, 1499040059999+int64(i)*60000)
+	}
+	b.WriteString("]")
+	return b.Bytes()
+}
+
+func BenchmarkParseKlines(b *testing.B) {
+	data := klinesFixture(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseKlines(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseKlinesTyped(b *testing.B) {
+	data := klinesFixture(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseKlinesTyped(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -148,6 +148,7 @@ func (s *marginOrderServiceTestSuite) TestCreateOrderFull() {
 				Quantity:        "344.00000000",
 				Commission:      "0.00332384",
 				CommissionAsset: "BNB",
+				TradeID:         1566397,
 			},
 		},
 	}
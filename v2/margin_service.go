@@ -2,6 +2,7 @@ package binance
 
 import (
 	"context"
+	stdjson "encoding/json"
 	"net/http"
 	"strings"
 )
@@ -598,6 +599,9 @@ type GetMarginAllPairsService struct {
 	c *Client
 }
 
+// GetAllMarginPairsService get all margin pairs info, see GetMarginAllPairsService
+type GetAllMarginPairsService = GetMarginAllPairsService
+
 // Do send request
 func (s *GetMarginAllPairsService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginAllPair, err error) {
 	r := &request{
@@ -617,16 +621,8 @@ func (s *GetMarginAllPairsService) Do(ctx context.Context, opts ...RequestOption
 	return res, nil
 }
 
-// MarginAllPair define margin pair info
-type MarginAllPair struct {
-	ID            int64  `json:"id"`
-	Symbol        string `json:"symbol"`
-	Base          string `json:"base"`
-	Quote         string `json:"quote"`
-	IsMarginTrade bool   `json:"isMarginTrade"`
-	IsBuyAllowed  bool   `json:"isBuyAllowed"`
-	IsSellAllowed bool   `json:"isSellAllowed"`
-}
+// MarginAllPair define margin pair info, it is the same payload as a single MarginPair
+type MarginAllPair = MarginPair
 
 // GetMarginPriceIndexService get margin price index
 type GetMarginPriceIndexService struct {
@@ -1129,3 +1125,184 @@ func (s *IsolatedMarginTransferService) Do(ctx context.Context, opts ...RequestO
 	}
 	return res, nil
 }
+
+// GetMarginForceLiquidationRecordService list margin force liquidation records
+type GetMarginForceLiquidationRecordService struct {
+	c              *Client
+	startTime      *int64
+	endTime        *int64
+	isolatedSymbol *string
+	current        *int64
+	size           *int64
+}
+
+// StartTime set start time
+func (s *GetMarginForceLiquidationRecordService) StartTime(startTime int64) *GetMarginForceLiquidationRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *GetMarginForceLiquidationRecordService) EndTime(endTime int64) *GetMarginForceLiquidationRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// IsolatedSymbol set isolated symbol, to query the records of an isolated margin account
+func (s *GetMarginForceLiquidationRecordService) IsolatedSymbol(isolatedSymbol string) *GetMarginForceLiquidationRecordService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
+// Current currently querying page. Start from 1. Default:1
+func (s *GetMarginForceLiquidationRecordService) Current(current int64) *GetMarginForceLiquidationRecordService {
+	s.current = &current
+	return s
+}
+
+// Size default:10 max:100
+func (s *GetMarginForceLiquidationRecordService) Size(size int64) *GetMarginForceLiquidationRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *GetMarginForceLiquidationRecordService) Do(ctx context.Context, opts ...RequestOption) (res *MarginForceLiquidationResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/margin/forceLiquidationRec",
+		secType:  secTypeSigned,
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.isolatedSymbol != nil {
+		r.setParam("isolatedSymbol", *s.isolatedSymbol)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginForceLiquidationResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginForceLiquidationResponse define margin force liquidation record response
+type MarginForceLiquidationResponse struct {
+	Rows  []MarginForceLiquidation `json:"rows"`
+	Total int64                    `json:"total"`
+}
+
+// MarginForceLiquidation define margin force liquidation record
+type MarginForceLiquidation struct {
+	AvgPrice         string          `json:"avgPrice"`
+	ExecutedQuantity string          `json:"executedQty"`
+	OrderID          int64           `json:"orderId"`
+	Price            string          `json:"price"`
+	Quantity         string          `json:"qty"`
+	Side             SideType        `json:"side"`
+	Symbol           string          `json:"symbol"`
+	TimeInForce      TimeInForceType `json:"timeInForce"`
+	IsIsolated       bool            `json:"isIsolated"`
+	UpdatedTime      int64           `json:"updatedTime"`
+}
+
+// GetCrossMarginCollateralRatioService get the collateral ratios of the cross margin assets
+type GetCrossMarginCollateralRatioService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetCrossMarginCollateralRatioService) Do(ctx context.Context, opts ...RequestOption) (res []CrossMarginCollateralRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/margin/crossMarginCollateralRatio",
+		secType:  secTypeAPIKey,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]CrossMarginCollateralRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CrossMarginCollateralRatio define the collateral ratio tiers of a group of assets
+type CrossMarginCollateralRatio struct {
+	Collaterals []CollateralInfo `json:"collaterals"`
+	AssetNames  []string         `json:"assetNames"`
+}
+
+// CollateralInfo define the discount rate of a collateral value tier
+type CollateralInfo struct {
+	MinUsdValue  string `json:"minUsdValue"`
+	MaxUsdValue  string `json:"maxUsdValue"`
+	DiscountRate string `json:"discountRate"`
+}
+
+// GetSmallLiabilityExchangeService get the small liability assets which can be exchanged for BNB
+type GetSmallLiabilityExchangeService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetSmallLiabilityExchangeService) Do(ctx context.Context, opts ...RequestOption) (res []SmallLiabilityAsset, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/margin/exchange-small-liability",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	// the liability quantity is sent as a number
+	raw := make([]struct {
+		Asset          string         `json:"asset"`
+		Interest       string         `json:"interest"`
+		Principal      string         `json:"principal"`
+		LiabilityAsset string         `json:"liabilityAsset"`
+		LiabilityQty   stdjson.Number `json:"liabilityQty"`
+	}, 0)
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]SmallLiabilityAsset, 0, len(raw))
+	for _, a := range raw {
+		res = append(res, SmallLiabilityAsset{
+			Asset:          a.Asset,
+			Interest:       a.Interest,
+			Principal:      a.Principal,
+			LiabilityAsset: a.LiabilityAsset,
+			LiabilityQty:   a.LiabilityQty.String(),
+		})
+	}
+	return res, nil
+}
+
+// SmallLiabilityAsset define a small liability asset
+type SmallLiabilityAsset struct {
+	Asset          string `json:"asset"`
+	Interest       string `json:"interest"`
+	Principal      string `json:"principal"`
+	LiabilityAsset string `json:"liabilityAsset"`
+	LiabilityQty   string `json:"liabilityQty"`
+}
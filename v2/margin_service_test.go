@@ -593,6 +593,39 @@ func (s *marginTestSuite) TestGetMarginAllPairs() {
 	}
 }
 
+func (s *marginTestSuite) TestGetAllMarginPairs() {
+	data := []byte(`[{
+		"id":351637150141315861,
+		"symbol":"BNBBTC",
+		"base":"BNB",
+		"quote":"BTC",
+		"isMarginTrade":true,
+		"isBuyAllowed":true,
+		"isSellAllowed":false
+	}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+	var service *GetAllMarginPairsService = s.client.NewGetAllMarginPairsService()
+	res, err := service.Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	s.assertMarginPairEqual(&MarginPair{
+		ID:            351637150141315861,
+		Symbol:        "BNBBTC",
+		Base:          "BNB",
+		Quote:         "BTC",
+		IsMarginTrade: true,
+		IsBuyAllowed:  true,
+		IsSellAllowed: false,
+	}, res[0])
+}
+
 func (s *marginTestSuite) assertMarginAllPairsEqual(e, a *MarginAllPair) {
 	r := s.r()
 	r.Equal(e.ID, a.ID, "ID")
@@ -912,3 +945,128 @@ func (s *marginTestSuite) TestIsolatedMarginTransferService() {
 	e := &TransactionResponse{TranID: 100000001}
 	s.r().Equal(res, e)
 }
+
+func (s *marginTestSuite) TestGetMarginForceLiquidationRecord() {
+	data := []byte(`{
+		"rows": [
+			{
+				"avgPrice": "0.00388359",
+				"executedQty": "31.39000000",
+				"orderId": 180015097,
+				"price": "0.00388110",
+				"qty": "31.39000000",
+				"side": "SELL",
+				"symbol": "BNBBTC",
+				"timeInForce": "GTC",
+				"isIsolated": true,
+				"updatedTime": 1558941374745
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	startTime := int64(1558941374000)
+	endTime := int64(1558941375000)
+	isolatedSymbol := "BNBBTC"
+	current := int64(1)
+	size := int64(10)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime":      startTime,
+			"endTime":        endTime,
+			"isolatedSymbol": isolatedSymbol,
+			"current":        current,
+			"size":           size,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetMarginForceLiquidationRecordService().StartTime(startTime).
+		EndTime(endTime).IsolatedSymbol(isolatedSymbol).Current(current).Size(size).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1), res.Total, "Total")
+	r.Len(res.Rows, 1)
+	e := MarginForceLiquidation{
+		AvgPrice:         "0.00388359",
+		ExecutedQuantity: "31.39000000",
+		OrderID:          180015097,
+		Price:            "0.00388110",
+		Quantity:         "31.39000000",
+		Side:             SideTypeSell,
+		Symbol:           "BNBBTC",
+		TimeInForce:      TimeInForceTypeGTC,
+		IsIsolated:       true,
+		UpdatedTime:      1558941374745,
+	}
+	r.Equal(e, res.Rows[0])
+}
+
+func (s *marginTestSuite) TestGetCrossMarginCollateralRatio() {
+	data := []byte(`[
+		{
+			"collaterals": [
+				{
+					"minUsdValue": "0",
+					"maxUsdValue": "13000000",
+					"discountRate": "1"
+				},
+				{
+					"minUsdValue": "13000000",
+					"maxUsdValue": "20000000",
+					"discountRate": "0.975"
+				}
+			],
+			"assetNames": ["BNX"]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetCrossMarginCollateralRatioService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]CrossMarginCollateralRatio{
+		{
+			Collaterals: []CollateralInfo{
+				{MinUsdValue: "0", MaxUsdValue: "13000000", DiscountRate: "1"},
+				{MinUsdValue: "13000000", MaxUsdValue: "20000000", DiscountRate: "0.975"},
+			},
+			AssetNames: []string{"BNX"},
+		},
+	}, res)
+}
+
+func (s *marginTestSuite) TestGetSmallLiabilityExchange() {
+	data := []byte(`[
+		{
+			"asset": "ETH",
+			"interest": "0.00083334",
+			"principal": "0.001",
+			"liabilityAsset": "USDT",
+			"liabilityQty": 0.3552
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetSmallLiabilityExchangeService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]SmallLiabilityAsset{
+		{
+			Asset:          "ETH",
+			Interest:       "0.00083334",
+			Principal:      "0.001",
+			LiabilityAsset: "USDT",
+			LiabilityQty:   "0.3552",
+		},
+	}, res)
+}
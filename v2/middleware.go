@@ -0,0 +1,68 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Request is the view of a request given to the middlewares
+type Request interface {
+	// Method return the HTTP method of the request
+	Method() string
+	// Endpoint return the path of the request
+	Endpoint() string
+	// Query return a copy of the query params of the request
+	Query() url.Values
+	// Header return a copy of the headers of the request, the headers of the request options
+	// and of the signature are only added after the middlewares
+	Header() http.Header
+}
+
+// RequestFunc send a request and return the response body and header
+type RequestFunc func(ctx context.Context, r Request) (data []byte, header http.Header, err error)
+
+// Middleware wrap a RequestFunc to intercept the requests of a client, for logging, metrics,
+// retries or caching
+type Middleware func(next RequestFunc) RequestFunc
+
+// Use add middlewares around every request of the client, the first one being the outermost.
+// Middlewares see the request before it is signed and the response before it is decoded.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// chain wrap f with the middlewares of the client
+func (c *Client) chain(f RequestFunc) RequestFunc {
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		f = c.middlewares[i](f)
+	}
+	return f
+}
+
+// Method return the HTTP method of the request
+func (r *request) Method() string {
+	return r.method
+}
+
+// Endpoint return the path of the request
+func (r *request) Endpoint() string {
+	return r.endpoint
+}
+
+// Query return a copy of the query params of the request
+func (r *request) Query() url.Values {
+	q := url.Values{}
+	for k, v := range r.query {
+		q[k] = append([]string(nil), v...)
+	}
+	return q
+}
+
+// Header return a copy of the headers of the request
+func (r *request) Header() http.Header {
+	if r.header == nil {
+		return http.Header{}
+	}
+	return r.header.Clone()
+}
@@ -0,0 +1,35 @@
+package binance_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUseOutsidePackage check that a middleware can be written outside of the package
+func TestUseOutsidePackage(t *testing.T) {
+	r := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"serverTime": 1499827319559}`))
+	}))
+	defer server.Close()
+
+	c := binance.NewClient("key", "secret")
+	c.BaseURL = server.URL
+	var seen []string
+	c.Use(func(next binance.RequestFunc) binance.RequestFunc {
+		return func(ctx context.Context, req binance.Request) ([]byte, http.Header, error) {
+			seen = append(seen, req.Method()+" "+req.Endpoint()+" "+req.Query().Encode()+" "+req.Header().Get("X-Test"))
+			return next(ctx, req)
+		}
+	})
+
+	serverTime, err := c.NewServerTimeService().Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(1499827319559), serverTime)
+	r.Equal([]string{"GET /api/v3/time  "}, seen)
+}
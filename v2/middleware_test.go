@@ -0,0 +1,67 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientUse(t *testing.T) {
+	r := require.New(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-MBX-USED-WEIGHT-1M", "5")
+		w.Write([]byte(`{"lastUpdateId": 1, "bids": [], "asks": []}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next RequestFunc) RequestFunc {
+			return func(ctx context.Context, r Request) ([]byte, http.Header, error) {
+				calls = append(calls, name+" "+r.Method()+" "+r.Endpoint()+" "+r.Query().Get("symbol"))
+				data, header, err := next(ctx, r)
+				calls = append(calls, name+" weight "+header.Get("X-Mbx-Used-Weight-1m"))
+				return data, header, err
+			}
+		}
+	}
+	cache := map[string][]byte{}
+	caching := func(next RequestFunc) RequestFunc {
+		return func(ctx context.Context, r Request) ([]byte, http.Header, error) {
+			key := r.Endpoint() + "?" + r.Query().Encode()
+			if data, ok := cache[key]; ok {
+				return data, http.Header{}, nil
+			}
+			data, header, err := next(ctx, r)
+			if err == nil {
+				cache[key] = data
+			}
+			return data, header, err
+		}
+	}
+	c.Use(trace("outer"), trace("inner"))
+	c.Use(caching)
+
+	res, err := c.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(1), res.LastUpdateID)
+	r.Equal([]string{
+		"outer GET /api/v3/depth BNBBTC",
+		"inner GET /api/v3/depth BNBBTC",
+		"inner weight 5",
+		"outer weight 5",
+	}, calls)
+
+	// served by the caching middleware
+	res, err = c.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(1), res.LastUpdateID)
+	r.Equal(1, requests)
+}
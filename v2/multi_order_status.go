@@ -0,0 +1,83 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// defaultOrderStatusConcurrency is the number of orders queried at once by GetMultipleOrdersStatus
+const defaultOrderStatusConcurrency = 5
+
+// WithOrderStatusConcurrency set the number of orders queried at once by GetMultipleOrdersStatus,
+// 5 by default
+func WithOrderStatusConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		c.orderStatusConcurrency = n
+	}
+}
+
+// MultiOrderError is returned by GetMultipleOrdersStatus when some of the orders could not be queried
+type MultiOrderError struct {
+	// Orders are the queried orders in the order of the requested ids, nil for the failed ones
+	Orders []*Order
+	// Errors are the errors of the failed orders by order id
+	Errors map[int64]error
+}
+
+func (e *MultiOrderError) Error() string {
+	ids := make([]int64, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	msgs := make([]string, len(ids))
+	for i, id := range ids {
+		msgs[i] = fmt.Sprintf("order %d: %v", id, e.Errors[id])
+	}
+	return fmt.Sprintf("%d of %d orders failed: %s", len(ids), len(e.Orders), strings.Join(msgs, "; "))
+}
+
+// GetMultipleOrdersStatus get the orders of orderIDs on symbol with concurrent GetOrderService calls,
+// see WithOrderStatusConcurrency. The orders are returned in the order of orderIDs.
+// When some calls fail the other orders are still returned, with a *MultiOrderError holding them
+// and the error of each failed order.
+func (c *Client) GetMultipleOrdersStatus(ctx context.Context, symbol string, orderIDs []int64, opts ...RequestOption) ([]*Order, error) {
+	concurrency := c.orderStatusConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultOrderStatusConcurrency
+	}
+	orders := make([]*Order, len(orderIDs))
+	errs := make([]error, len(orderIDs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, orderID := range orderIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, orderID int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			orders[i], errs[i] = c.NewGetOrderService().Symbol(symbol).OrderID(orderID).Do(ctx, opts...)
+		}(i, orderID)
+	}
+	wg.Wait()
+
+	var multiErr *MultiOrderError
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if multiErr == nil {
+			multiErr = &MultiOrderError{Orders: orders, Errors: make(map[int64]error)}
+		}
+		multiErr.Errors[orderIDs[i]] = err
+	}
+	if multiErr != nil {
+		return orders, multiErr
+	}
+	return orders, nil
+}
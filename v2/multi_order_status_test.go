@@ -0,0 +1,59 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMultipleOrdersStatus(t *testing.T) {
+	r := require.New(t)
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		orderID := req.URL.Query().Get("orderId")
+		if orderID == "3" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code":-2013,"msg":"Order does not exist."}`)
+			return
+		}
+		fmt.Fprintf(w, `{"symbol":"BTCUSDT","orderId":%s,"status":"FILLED"}`, orderID)
+	}))
+	defer server.Close()
+	c := NewClient("", "", WithOrderStatusConcurrency(2))
+	c.BaseURL = server.URL
+
+	orders, err := c.GetMultipleOrdersStatus(newContext(), "BTCUSDT", []int64{5, 1, 4, 2})
+	r.NoError(err)
+	r.Len(orders, 4)
+	for i, id := range []int64{5, 1, 4, 2} {
+		r.Equal(id, orders[i].OrderID)
+		r.Equal(OrderStatusTypeFilled, orders[i].Status)
+	}
+	r.LessOrEqual(atomic.LoadInt32(&maxInFlight), int32(2))
+
+	orders, err = c.GetMultipleOrdersStatus(newContext(), "BTCUSDT", []int64{1, 3, 2})
+	var multiErr *MultiOrderError
+	r.True(errors.As(err, &multiErr))
+	r.Len(multiErr.Errors, 1)
+	r.True(common.IsAPIError(multiErr.Errors[3]))
+	r.Equal(orders, multiErr.Orders)
+	r.Equal(int64(1), orders[0].OrderID)
+	r.Nil(orders[1])
+	r.Equal(int64(2), orders[2].OrderID)
+}
@@ -0,0 +1,289 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetNFTTransactionHistoryService retrieve the NFT transactions of the account
+// See https://binance-docs.github.io/apidocs/spot/en/#get-nft-transaction-history-user_data
+type GetNFTTransactionHistoryService struct {
+	c         *Client
+	orderType NFTOrderType
+	startTime *int64
+	endTime   *int64
+	limit     *int32
+	page      *int32
+}
+
+// OrderType set orderType
+func (s *GetNFTTransactionHistoryService) OrderType(orderType NFTOrderType) *GetNFTTransactionHistoryService {
+	s.orderType = orderType
+	return s
+}
+
+// StartTime set startTime
+func (s *GetNFTTransactionHistoryService) StartTime(startTime int64) *GetNFTTransactionHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetNFTTransactionHistoryService) EndTime(endTime int64) *GetNFTTransactionHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default 50 and max 50
+func (s *GetNFTTransactionHistoryService) Limit(limit int32) *GetNFTTransactionHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Page set page, default 1
+func (s *GetNFTTransactionHistoryService) Page(page int32) *GetNFTTransactionHistoryService {
+	s.page = &page
+	return s
+}
+
+// Do send request
+func (s *GetNFTTransactionHistoryService) Do(ctx context.Context, opts ...RequestOption) (*NFTTransactionHistory, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/nft/history/transactions",
+		secType:  secTypeSigned,
+	}
+	r.setParam("orderType", s.orderType)
+	setNFTPageParams(r, s.startTime, s.endTime, s.limit, s.page)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := NFTTransactionHistory{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// NFTTransactionHistory define a page of NFT transactions
+type NFTTransactionHistory struct {
+	Total int64             `json:"total"`
+	List  []*NFTTransaction `json:"list"`
+}
+
+// NFTTransaction define an NFT transaction
+type NFTTransaction struct {
+	OrderNo       string      `json:"orderNo"`
+	Tokens        []*NFTToken `json:"tokens"`
+	TradeTime     int64       `json:"tradeTime"`
+	TradeAmount   string      `json:"tradeAmount"`
+	TradeCurrency string      `json:"tradeCurrency"`
+}
+
+// NFTToken define an NFT
+type NFTToken struct {
+	Network         string `json:"network"`
+	TokenID         string `json:"tokenId"`
+	ContractAddress string `json:"contractAddress"`
+}
+
+// GetNFTDepositHistoryService retrieve the NFT deposits of the account
+// See https://binance-docs.github.io/apidocs/spot/en/#get-nft-deposit-history-user_data
+type GetNFTDepositHistoryService struct {
+	c         *Client
+	startTime *int64
+	endTime   *int64
+	limit     *int32
+	page      *int32
+}
+
+// StartTime set startTime
+func (s *GetNFTDepositHistoryService) StartTime(startTime int64) *GetNFTDepositHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetNFTDepositHistoryService) EndTime(endTime int64) *GetNFTDepositHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default 50 and max 50
+func (s *GetNFTDepositHistoryService) Limit(limit int32) *GetNFTDepositHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Page set page, default 1
+func (s *GetNFTDepositHistoryService) Page(page int32) *GetNFTDepositHistoryService {
+	s.page = &page
+	return s
+}
+
+// Do send request
+func (s *GetNFTDepositHistoryService) Do(ctx context.Context, opts ...RequestOption) (*NFTDepositHistory, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/nft/history/deposit",
+		secType:  secTypeSigned,
+	}
+	setNFTPageParams(r, s.startTime, s.endTime, s.limit, s.page)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := NFTDepositHistory{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// NFTDepositHistory define a page of NFT deposits
+type NFTDepositHistory struct {
+	Total int64         `json:"total"`
+	List  []*NFTDeposit `json:"list"`
+}
+
+// NFTDeposit define an NFT deposit
+type NFTDeposit struct {
+	Network string `json:"network"`
+	TxID    string `json:"txID"`
+	// the API misspells the contract address key
+	ContractAddress string `json:"contractAdrress"`
+	TokenID         string `json:"tokenId"`
+	Timestamp       int64  `json:"timestamp"`
+}
+
+// GetNFTWithdrawHistoryService retrieve the NFT withdrawals of the account
+// See https://binance-docs.github.io/apidocs/spot/en/#get-nft-withdraw-history-user_data
+type GetNFTWithdrawHistoryService struct {
+	c         *Client
+	startTime *int64
+	endTime   *int64
+	limit     *int32
+	page      *int32
+}
+
+// StartTime set startTime
+func (s *GetNFTWithdrawHistoryService) StartTime(startTime int64) *GetNFTWithdrawHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetNFTWithdrawHistoryService) EndTime(endTime int64) *GetNFTWithdrawHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default 50 and max 50
+func (s *GetNFTWithdrawHistoryService) Limit(limit int32) *GetNFTWithdrawHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Page set page, default 1
+func (s *GetNFTWithdrawHistoryService) Page(page int32) *GetNFTWithdrawHistoryService {
+	s.page = &page
+	return s
+}
+
+// Do send request
+func (s *GetNFTWithdrawHistoryService) Do(ctx context.Context, opts ...RequestOption) (*NFTWithdrawHistory, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/nft/history/withdraw",
+		secType:  secTypeSigned,
+	}
+	setNFTPageParams(r, s.startTime, s.endTime, s.limit, s.page)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := NFTWithdrawHistory{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// NFTWithdrawHistory define a page of NFT withdrawals
+type NFTWithdrawHistory struct {
+	Total int64          `json:"total"`
+	List  []*NFTWithdraw `json:"list"`
+}
+
+// NFTWithdraw define an NFT withdrawal
+type NFTWithdraw struct {
+	Network string `json:"network"`
+	TxID    string `json:"txID"`
+	// the API misspells the contract address key
+	ContractAddress string  `json:"contractAdrress"`
+	TokenID         string  `json:"tokenId"`
+	Timestamp       int64   `json:"timestamp"`
+	Fee             float64 `json:"fee"`
+	FeeAsset        string  `json:"feeAsset"`
+}
+
+// GetNFTUserAssetService retrieve the NFTs held by the account
+// See https://binance-docs.github.io/apidocs/spot/en/#get-nft-asset-user_data
+type GetNFTUserAssetService struct {
+	c     *Client
+	limit *int32
+	page  *int32
+}
+
+// Limit set limit, default 50 and max 50
+func (s *GetNFTUserAssetService) Limit(limit int32) *GetNFTUserAssetService {
+	s.limit = &limit
+	return s
+}
+
+// Page set page, default 1
+func (s *GetNFTUserAssetService) Page(page int32) *GetNFTUserAssetService {
+	s.page = &page
+	return s
+}
+
+// Do send request
+func (s *GetNFTUserAssetService) Do(ctx context.Context, opts ...RequestOption) (*NFTUserAssets, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/nft/user/getAsset",
+		secType:  secTypeSigned,
+	}
+	setNFTPageParams(r, nil, nil, s.limit, s.page)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := NFTUserAssets{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// NFTUserAssets define a page of the NFTs held by the account
+type NFTUserAssets struct {
+	Total int64       `json:"total"`
+	List  []*NFTToken `json:"list"`
+}
+
+func setNFTPageParams(r *request, startTime, endTime *int64, limit, page *int32) {
+	if startTime != nil {
+		r.setParam("startTime", *startTime)
+	}
+	if endTime != nil {
+		r.setParam("endTime", *endTime)
+	}
+	if limit != nil {
+		r.setParam("limit", *limit)
+	}
+	if page != nil {
+		r.setParam("page", *page)
+	}
+}
@@ -0,0 +1,182 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type nftServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestNFTService(t *testing.T) {
+	suite.Run(t, new(nftServiceTestSuite))
+}
+
+func (s *nftServiceTestSuite) TestGetNFTTransactionHistory() {
+	data := []byte(`{
+		"total": 1,
+		"list": [
+			{
+				"orderNo": "1_470502070600699904",
+				"tokens": [
+					{
+						"network": "BSC",
+						"tokenId": "216000000496",
+						"contractAddress": "MYSTERY_BOX0000087"
+					}
+				],
+				"tradeTime": 1626941236000,
+				"tradeAmount": "19.60000000",
+				"tradeCurrency": "BNB"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"orderType":  NFTOrderTypeSale,
+			"startTime":  int64(1626941230000),
+			"endTime":    int64(1626941240000),
+			"limit":      int32(50),
+			"page":       int32(2),
+			"recvWindow": int64(5000),
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetNFTTransactionHistoryService().OrderType(NFTOrderTypeSale).
+		StartTime(1626941230000).EndTime(1626941240000).Limit(50).Page(2).
+		Do(newContext(), WithRecvWindow(5000))
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&NFTTransactionHistory{
+		Total: 1,
+		List: []*NFTTransaction{
+			{
+				OrderNo: "1_470502070600699904",
+				Tokens: []*NFTToken{
+					{Network: "BSC", TokenID: "216000000496", ContractAddress: "MYSTERY_BOX0000087"},
+				},
+				TradeTime:     1626941236000,
+				TradeAmount:   "19.60000000",
+				TradeCurrency: "BNB",
+			},
+		},
+	}, res)
+}
+
+func (s *nftServiceTestSuite) TestGetNFTDepositHistory() {
+	data := []byte(`{
+		"total": 1,
+		"list": [
+			{
+				"network": "ETH",
+				"txID": null,
+				"contractAdrress": "0xe507c961ee127d4439977a61af39c34eafee0dc6",
+				"tokenId": "10014",
+				"timestamp": 1629986047000
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime": int64(1629986040000),
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetNFTDepositHistoryService().StartTime(1629986040000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&NFTDepositHistory{
+		Total: 1,
+		List: []*NFTDeposit{
+			{
+				Network:         "ETH",
+				ContractAddress: "0xe507c961ee127d4439977a61af39c34eafee0dc6",
+				TokenID:         "10014",
+				Timestamp:       1629986047000,
+			},
+		},
+	}, res)
+}
+
+func (s *nftServiceTestSuite) TestGetNFTWithdrawHistory() {
+	data := []byte(`{
+		"total": 1,
+		"list": [
+			{
+				"network": "ETH",
+				"txID": "0x2be5eed31d787fdb4880bc631c8e76bdfb6150e137f5cf1732e0416ea206f6f3",
+				"contractAdrress": "0xe507c961ee127d4439977a61af39c34eafee0dc6",
+				"tokenId": "1000001247",
+				"timestamp": 1633674433000,
+				"fee": 0.1,
+				"feeAsset": "ETH"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"endTime": int64(1633674440000),
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetNFTWithdrawHistoryService().EndTime(1633674440000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.List, 1)
+	r.Equal(&NFTWithdraw{
+		Network:         "ETH",
+		TxID:            "0x2be5eed31d787fdb4880bc631c8e76bdfb6150e137f5cf1732e0416ea206f6f3",
+		ContractAddress: "0xe507c961ee127d4439977a61af39c34eafee0dc6",
+		TokenID:         "1000001247",
+		Timestamp:       1633674433000,
+		Fee:             0.1,
+		FeeAsset:        "ETH",
+	}, res.List[0])
+}
+
+func (s *nftServiceTestSuite) TestGetNFTUserAsset() {
+	data := []byte(`{
+		"total": 1,
+		"list": [
+			{
+				"network": "BSC",
+				"contractAddress": "REGULAR11234567891779",
+				"tokenId": "100900000017"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"limit": int32(10),
+			"page":  int32(1),
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetNFTUserAssetService().Limit(10).Page(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&NFTUserAssets{
+		Total: 1,
+		List: []*NFTToken{
+			{Network: "BSC", ContractAddress: "REGULAR11234567891779", TokenID: "100900000017"},
+		},
+	}, res)
+}
@@ -0,0 +1,163 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+)
+
+// AmendOrderKeepPriorityService reduce the quantity of an open order without losing its priority in the order book.
+// By default the order is queried first so that newQty is checked against the remaining quantity before the
+// amendment is sent, this costs an extra GET /api/v3/order request, CheckRemainingQty(false) skips it.
+// See https://developers.binance.com/docs/binance-spot-api-docs/rest-api/trading-endpoints#order-amend-keep-priority-trade
+type AmendOrderKeepPriorityService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	newClientOrderID  *string
+	newQty            string
+	checkRemaining    bool
+}
+
+// Symbol set symbol
+func (s *AmendOrderKeepPriorityService) Symbol(symbol string) *AmendOrderKeepPriorityService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *AmendOrderKeepPriorityService) OrderID(orderID int64) *AmendOrderKeepPriorityService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *AmendOrderKeepPriorityService) OrigClientOrderID(origClientOrderID string) *AmendOrderKeepPriorityService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID, the new client order id of the amended order
+func (s *AmendOrderKeepPriorityService) NewClientOrderID(newClientOrderID string) *AmendOrderKeepPriorityService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewQty set newQty, it must be greater than 0 and less than the remaining quantity of the order
+func (s *AmendOrderKeepPriorityService) NewQty(newQty string) *AmendOrderKeepPriorityService {
+	s.newQty = newQty
+	return s
+}
+
+// CheckRemainingQty set whether the order is queried to check newQty against its remaining quantity, true by default
+func (s *AmendOrderKeepPriorityService) CheckRemainingQty(check bool) *AmendOrderKeepPriorityService {
+	s.checkRemaining = check
+	return s
+}
+
+func (s *AmendOrderKeepPriorityService) validate(ctx context.Context, opts ...RequestOption) error {
+	if s.symbol == "" {
+		return fmt.Errorf("%w: symbol is required", ErrInvalidParam)
+	}
+	if s.orderID == nil && s.origClientOrderID == nil {
+		return fmt.Errorf("%w: either orderId or origClientOrderId must be sent", ErrInvalidParam)
+	}
+	// big.Rat also parses fractions such as 1/3, which are not quantities
+	newQty, ok := new(big.Rat).SetString(s.newQty)
+	if !ok || newQty.Sign() <= 0 || strings.Contains(s.newQty, "/") {
+		return fmt.Errorf("%w: invalid newQty %q", ErrInvalidParam, s.newQty)
+	}
+	if !s.checkRemaining {
+		return nil
+	}
+	getOrder := s.c.NewGetOrderService().Symbol(s.symbol)
+	if s.orderID != nil {
+		getOrder.OrderID(*s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		getOrder.OrigClientOrderID(*s.origClientOrderID)
+	}
+	order, err := getOrder.Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	// the quantities are compared as exact decimals, a float64 would round quantities with many digits
+	origQty, ok := new(big.Rat).SetString(order.OrigQuantity)
+	if !ok {
+		return fmt.Errorf("invalid origQty %q", order.OrigQuantity)
+	}
+	executedQty, ok := new(big.Rat).SetString(order.ExecutedQuantity)
+	if !ok {
+		return fmt.Errorf("invalid executedQty %q", order.ExecutedQuantity)
+	}
+	if remaining := origQty.Sub(origQty, executedQty); newQty.Cmp(remaining) >= 0 {
+		return fmt.Errorf("%w: newQty %s must be less than the remaining quantity %s",
+			ErrInvalidParam, s.newQty, remaining.FloatString(8))
+	}
+	return nil
+}
+
+// Do send request
+func (s *AmendOrderKeepPriorityService) Do(ctx context.Context, opts ...RequestOption) (res *AmendOrderKeepPriorityResponse, err error) {
+	if err = s.validate(ctx, opts...); err != nil {
+		return nil, err
+	}
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/api/v3/order/amend/keepPriority",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.newClientOrderID != nil {
+		r.setParam("newClientOrderId", *s.newClientOrderID)
+	}
+	r.setParam("newQty", s.newQty)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AmendOrderKeepPriorityResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AmendOrderKeepPriorityResponse define the response of an order amendment,
+// TransactTime and ExecutionID match the ones of the executionReport of the amendment
+type AmendOrderKeepPriorityResponse struct {
+	TransactTime int64         `json:"transactTime"`
+	ExecutionID  int64         `json:"executionId"`
+	AmendedOrder *AmendedOrder `json:"amendedOrder"`
+}
+
+// AmendedOrder define the order after an amendment
+type AmendedOrder struct {
+	Symbol                  string          `json:"symbol"`
+	OrderID                 int64           `json:"orderId"`
+	OrderListID             int64           `json:"orderListId"`
+	OrigClientOrderID       string          `json:"origClientOrderId"`
+	ClientOrderID           string          `json:"clientOrderId"`
+	Price                   string          `json:"price"`
+	Quantity                string          `json:"qty"`
+	ExecutedQuantity        string          `json:"executedQty"`
+	PreventedQuantity       string          `json:"preventedQty"`
+	QuoteOrderQuantity      string          `json:"quoteOrderQty"`
+	CumulativeQuoteQuantity string          `json:"cumulativeQuoteQty"`
+	Status                  OrderStatusType `json:"status"`
+	TimeInForce             TimeInForceType `json:"timeInForce"`
+	Type                    OrderType       `json:"type"`
+	Side                    SideType        `json:"side"`
+	WorkingTime             int64           `json:"workingTime"`
+	SelfTradePreventionMode STPMode         `json:"selfTradePreventionMode"`
+}
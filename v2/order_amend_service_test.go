@@ -0,0 +1,114 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmendOrderKeepPriority(t *testing.T) {
+	r := require.New(t)
+	var queried, amended int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/api/v3/order":
+			queried++
+			r.Equal("BTCUSDT", q.Get("symbol"))
+			if q.Get("orderId") == "13" {
+				fmt.Fprint(w, `{"symbol":"BTCUSDT","orderId":13,"origQty":"0.40000000","executedQty":"0.10000000","status":"PARTIALLY_FILLED"}`)
+				return
+			}
+			r.Equal("12", q.Get("orderId"))
+			fmt.Fprint(w, `{"symbol":"BTCUSDT","orderId":12,"origQty":"10.00000000","executedQty":"4.00000000","status":"PARTIALLY_FILLED"}`)
+		case req.Method == http.MethodPut && req.URL.Path == "/api/v3/order/amend/keepPriority":
+			amended++
+			r.Equal("BTCUSDT", q.Get("symbol"))
+			r.Equal("12", q.Get("orderId"))
+			r.Equal("amended", q.Get("newClientOrderId"))
+			r.Equal("5", q.Get("newQty"))
+			fmt.Fprint(w, `{
+				"transactTime": 1741926410255,
+				"executionId": 75,
+				"amendedOrder": {
+					"symbol": "BTCUSDT",
+					"orderId": 12,
+					"orderListId": -1,
+					"origClientOrderId": "origin",
+					"clientOrderId": "amended",
+					"price": "6.00000000",
+					"qty": "5.00000000",
+					"executedQty": "4.00000000",
+					"preventedQty": "0.00000000",
+					"quoteOrderQty": "0.00000000",
+					"cumulativeQuoteQty": "24.00000000",
+					"status": "PARTIALLY_FILLED",
+					"timeInForce": "GTC",
+					"type": "LIMIT",
+					"side": "SELL",
+					"workingTime": 1741926410242,
+					"selfTradePreventionMode": "NONE"
+				}
+			}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	c := NewClient("", "")
+	c.BaseURL = server.URL
+
+	res, err := c.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderID(12).
+		NewClientOrderID("amended").NewQty("5").CheckRemainingQty(false).Do(newContext())
+	r.NoError(err)
+	r.Equal(int64(1741926410255), res.TransactTime)
+	r.Equal(int64(75), res.ExecutionID)
+	r.Equal(&AmendedOrder{
+		Symbol:                  "BTCUSDT",
+		OrderID:                 12,
+		OrderListID:             -1,
+		OrigClientOrderID:       "origin",
+		ClientOrderID:           "amended",
+		Price:                   "6.00000000",
+		Quantity:                "5.00000000",
+		ExecutedQuantity:        "4.00000000",
+		PreventedQuantity:       "0.00000000",
+		QuoteOrderQuantity:      "0.00000000",
+		CumulativeQuoteQuantity: "24.00000000",
+		Status:                  OrderStatusTypePartiallyFilled,
+		TimeInForce:             TimeInForceTypeGTC,
+		Type:                    OrderTypeLimit,
+		Side:                    SideTypeSell,
+		WorkingTime:             1741926410242,
+		SelfTradePreventionMode: STPModeNone,
+	}, res.AmendedOrder)
+	r.Equal(1, amended)
+	// the order is not queried with CheckRemainingQty(false)
+	r.Equal(0, queried)
+
+	_, err = c.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderID(12).
+		NewClientOrderID("amended").NewQty("5").Do(newContext())
+	r.NoError(err)
+	r.Equal(1, queried)
+	r.Equal(2, amended)
+
+	// 6 remain on the order, newQty must be less than that
+	_, err = c.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderID(12).NewQty("6").Do(newContext())
+	r.True(errors.Is(err, ErrInvalidParam))
+	// 0.3 remain exactly, 0.4 - 0.1 in float64 would let newQty 0.3 through
+	_, err = c.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderID(13).NewQty("0.3").Do(newContext())
+	r.True(errors.Is(err, ErrInvalidParam))
+	r.Contains(err.Error(), "remaining quantity 0.30000000")
+	_, err = c.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").NewQty("1").Do(newContext())
+	r.True(errors.Is(err, ErrInvalidParam))
+	_, err = c.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderID(12).NewQty("0").Do(newContext())
+	r.True(errors.Is(err, ErrInvalidParam))
+	_, err = c.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderID(12).NewQty("1/3").Do(newContext())
+	r.True(errors.Is(err, ErrInvalidParam))
+	r.Equal(3, queried)
+	r.Equal(2, amended)
+}
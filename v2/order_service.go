@@ -21,6 +21,7 @@ type CreateOrderService struct {
 	stopPrice        *string
 	trailingDelta    *string
 	icebergQuantity  *string
+	stpMode          *STPMode
 }
 
 // Symbol set symbol
@@ -95,6 +96,17 @@ func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType)
 	return s
 }
 
+// SelfTradePreventionMode set selfTradePreventionMode, the account default mode is used when not set
+func (s *CreateOrderService) SelfTradePreventionMode(stpMode STPMode) *CreateOrderService {
+	s.stpMode = &stpMode
+	return s
+}
+
+// SetSTPMode set selfTradePreventionMode, see SelfTradePreventionMode
+func (s *CreateOrderService) SetSTPMode(stpMode STPMode) *CreateOrderService {
+	return s.SelfTradePreventionMode(stpMode)
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
 		method:   http.MethodPost,
@@ -133,6 +145,9 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
+	if s.stpMode != nil {
+		m["selfTradePreventionMode"] = *s.stpMode
+	}
 	r.setFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -152,17 +167,35 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	if err != nil {
 		return nil, err
 	}
+	res.ResponseType = s.responseType()
 	return res, nil
 }
 
+// responseType return the requested newOrderRespType, or the one Binance defaults to:
+// FULL for MARKET and LIMIT orders, ACK for the other types
+func (s *CreateOrderService) responseType() NewOrderRespType {
+	if s.newOrderRespType != nil {
+		return *s.newOrderRespType
+	}
+	if s.orderType == OrderTypeMarket || s.orderType == OrderTypeLimit {
+		return NewOrderRespTypeFULL
+	}
+	return NewOrderRespTypeACK
+}
+
 // Test send test api to check if the request is valid
 func (s *CreateOrderService) Test(ctx context.Context, opts ...RequestOption) (err error) {
 	_, err = s.createOrder(ctx, "/api/v3/order/test", opts...)
 	return err
 }
 
-// CreateOrderResponse define create order response
+// CreateOrderResponse define create order response.
+// An ACK response only sets Symbol, OrderID, ClientOrderID and TransactTime,
+// a RESULT response adds the order fields and a FULL response adds the Fills.
 type CreateOrderResponse struct {
+	// ResponseType is the newOrderRespType of the response, it is not part of the payload
+	ResponseType NewOrderRespType `json:"-"`
+
 	Symbol                   string `json:"symbol"`
 	OrderID                  int64  `json:"orderId"`
 	ClientOrderID            string `json:"clientOrderId"`
@@ -178,6 +211,8 @@ type CreateOrderResponse struct {
 	Type        OrderType       `json:"type"`
 	Side        SideType        `json:"side"`
 
+	SelfTradePreventionMode STPMode `json:"selfTradePreventionMode"`
+
 	// for order response is set to FULL
 	Fills                 []*Fill `json:"fills"`
 	MarginBuyBorrowAmount string  `json:"marginBuyBorrowAmount"` // for margin
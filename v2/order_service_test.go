@@ -84,6 +84,37 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderWithSTPMode() {
+	data := []byte(`{
+		"symbol": "LTCBTC",
+		"orderId": 1,
+		"status": "NEW",
+		"type": "LIMIT",
+		"side": "BUY",
+		"selfTradePreventionMode": "EXPIRE_MAKER"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "LTCBTC",
+			"side":                    SideTypeBuy,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTC,
+			"quantity":                "1",
+			"price":                   "0.0001",
+			"selfTradePreventionMode": STPModeExpireMaker,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("0.0001").
+		SetSTPMode(STPModeExpireMaker).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(STPModeExpireMaker, res.SelfTradePreventionMode)
+	s.r().Equal(STPModeExpireTaker, *s.client.NewCreateOrderService().SelfTradePreventionMode(STPModeExpireTaker).stpMode)
+}
+
 func (s *orderServiceTestSuite) TestCreateOrderFull() {
 	data := []byte(`{
 		"symbol": "LTCBTC",
@@ -157,6 +188,7 @@ func (s *orderServiceTestSuite) TestCreateOrderFull() {
 				Quantity:        "344.00000000",
 				Commission:      "0.00332384",
 				CommissionAsset: "BNB",
+				TradeID:         1566397,
 			},
 		},
 	}
@@ -196,6 +228,54 @@ func (s *baseOrderTestSuite) assertFillEqual(e, a *Fill) {
 	r.Equal(e.CommissionAsset, a.CommissionAsset, "CommissionAsset")
 	r.Equal(e.Price, a.Price, "Price")
 	r.Equal(e.Quantity, a.Quantity, "Quantity")
+	r.Equal(e.TradeID, a.TradeID, "TradeID")
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderResponseTypes() {
+	ack := `{"symbol": "BTCUSDT", "orderId": 28, "orderListId": -1, "clientOrderId": "6gCrw2kRUAF9CvJDGP16IP", "transactTime": 1507725176595}`
+	result := `{"symbol": "BTCUSDT", "orderId": 28, "orderListId": -1, "clientOrderId": "6gCrw2kRUAF9CvJDGP16IP", "transactTime": 1507725176595,
+		"price": "0.00000000", "origQty": "10.00000000", "executedQty": "10.00000000", "cummulativeQuoteQty": "10.00000000",
+		"status": "FILLED", "timeInForce": "GTC", "type": "MARKET", "side": "SELL"}`
+	full := `{"symbol": "BTCUSDT", "orderId": 28, "orderListId": -1, "clientOrderId": "6gCrw2kRUAF9CvJDGP16IP", "transactTime": 1507725176595,
+		"price": "0.00000000", "origQty": "10.00000000", "executedQty": "10.00000000", "cummulativeQuoteQty": "10.00000000",
+		"status": "FILLED", "timeInForce": "GTC", "type": "MARKET", "side": "SELL",
+		"fills": [
+			{"price": "4000.00000000", "qty": "1.00000000", "commission": "4.00000000", "commissionAsset": "USDT", "tradeId": 56},
+			{"price": "3999.00000000", "qty": "9.00000000", "commission": "35.99100000", "commissionAsset": "USDT", "tradeId": 57}
+		]}`
+	tests := []struct {
+		data         string
+		orderType    OrderType
+		respType     NewOrderRespType
+		expectedType NewOrderRespType
+		status       OrderStatusType
+		fills        int
+	}{
+		{data: ack, orderType: OrderTypeStopLossLimit, expectedType: NewOrderRespTypeACK},
+		{data: result, orderType: OrderTypeMarket, respType: NewOrderRespTypeRESULT,
+			expectedType: NewOrderRespTypeRESULT, status: OrderStatusTypeFilled},
+		{data: full, orderType: OrderTypeMarket, expectedType: NewOrderRespTypeFULL, status: OrderStatusTypeFilled, fills: 2},
+	}
+	for _, test := range tests {
+		s.Run(string(test.expectedType), func() {
+			s.SetupTest()
+			s.mockDo([]byte(test.data), nil)
+			defer s.assertDo()
+			service := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+				Type(test.orderType).Quantity("10")
+			if test.respType != "" {
+				service.NewOrderRespType(test.respType)
+			}
+			res, err := service.Do(newContext())
+			r := s.r()
+			r.NoError(err)
+			r.Equal(test.expectedType, res.ResponseType)
+			r.Equal(int64(28), res.OrderID)
+			r.Equal(int64(1507725176595), res.TransactTime)
+			r.Equal(test.status, res.Status)
+			r.Len(res.Fills, test.fills)
+		})
+	}
 }
 
 func (s *orderServiceTestSuite) TestCreateOCO() {
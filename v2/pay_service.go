@@ -5,7 +5,7 @@ import (
 	"net/http"
 )
 
-// PayTransactionService retrieve the fiat deposit/withdraw history
+// PayTradeHistoryService retrieve the Binance Pay transactions
 type PayTradeHistoryService struct {
 	c              *Client
 	startTimestamp *int64
@@ -25,7 +25,10 @@ func (s *PayTradeHistoryService) EndTimestamp(endTimestamp int64) *PayTradeHisto
 	return s
 }
 
-// Rows set rows
+// GetPayTradeHistoryService retrieve the Binance Pay transactions, see PayTradeHistoryService
+type GetPayTradeHistoryService = PayTradeHistoryService
+
+// Limit set limit, default 100 and max 100
 func (s *PayTradeHistoryService) Limit(limit int32) *PayTradeHistoryService {
 	s.limit = &limit
 	return s
@@ -58,6 +61,7 @@ func (s *PayTradeHistoryService) Do(ctx context.Context, opts ...RequestOption)
 	return &res, nil
 }
 
+// PayTradeHistory define the response of PayTradeHistoryService
 type PayTradeHistory struct {
 	Code    string         `json:"code"`
 	Message string         `json:"message"`
@@ -65,6 +69,7 @@ type PayTradeHistory struct {
 	Success bool           `json:"success"`
 }
 
+// PayTradeItem define a Binance Pay transaction
 type PayTradeItem struct {
 	OrderType       string        `json:"orderType"`
 	TransactionID   string        `json:"transactionId"`
@@ -74,6 +79,10 @@ type PayTradeItem struct {
 	FundsDetail     []FundsDetail `json:"fundsDetail"`
 }
 
+// PayTransaction define a Binance Pay transaction, see PayTradeItem
+type PayTransaction = PayTradeItem
+
+// FundsDetail define an asset moved by a Binance Pay transaction
 type FundsDetail struct {
 	Currency string `json:"currency"`
 	Amount   string `json:"amount"`
@@ -43,11 +43,16 @@ func (s *payServiceTestSuite) TestPayTradeHistory() {
 	defer s.assertDo()
 
 	s.assertReq(func(r *request) {
-		e := newSignedRequest().setParams(params{})
+		e := newSignedRequest().setParams(params{
+			"startTimestamp": int64(1610090460000),
+			"endTimestamp":   int64(1610090470000),
+			"limit":          int32(50),
+		})
 		s.assertRequestEqual(e, r)
 	})
 
-	res, err := s.client.NewPayTradeHistoryService().Do(newContext())
+	res, err := s.client.NewPayTradeHistoryService().StartTimestamp(1610090460000).
+		EndTimestamp(1610090470000).Limit(50).Do(newContext())
 	s.r().NoError(err)
 	e := &PayTradeHistory{
 		Code:    "000000",
@@ -29,6 +29,7 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+	forceHost  string
 }
 
 // addParam add param with key/value to query string
@@ -122,3 +123,10 @@ func WithHeaders(header http.Header) RequestOption {
 		r.header = header.Clone()
 	}
 }
+
+// WithForceHost send the request to baseURL only, bypassing BaseURL and the fallback hosts
+func WithForceHost(baseURL string) RequestOption {
+	return func(r *request) {
+		r.forceHost = baseURL
+	}
+}
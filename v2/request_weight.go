@@ -0,0 +1,150 @@
+package binance
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// LimitWeight define the weight of requests whose limit param is at most MaxLimit
+type LimitWeight struct {
+	MaxLimit int
+	Weight   int
+}
+
+// EndpointWeight define the request weight of an endpoint
+type EndpointWeight struct {
+	Weight int
+	// NoSymbolWeight overrides Weight when the request has no symbol param,
+	// for the endpoints returning every symbol or a symbols list in that case
+	NoSymbolWeight int
+	// DefaultLimit is the limit applied by the server when the request has none
+	DefaultLimit int
+	// LimitWeights override Weight according to the limit param, sorted by MaxLimit,
+	// the last bucket applies to limits above every MaxLimit
+	LimitWeights []LimitWeight
+}
+
+// weight return the weight of a request with the given limit, zero if unset
+func (w EndpointWeight) weight(limit int) int {
+	if len(w.LimitWeights) == 0 {
+		return w.Weight
+	}
+	if limit <= 0 {
+		limit = w.DefaultLimit
+	}
+	for _, b := range w.LimitWeights {
+		if limit <= b.MaxLimit {
+			return b.Weight
+		}
+	}
+	return w.LimitWeights[len(w.LimitWeights)-1].Weight
+}
+
+// DefaultEndpointWeight is the weight of endpoints missing from EndpointWeights
+const DefaultEndpointWeight = 1
+
+// EndpointWeights is the request weight of the spot API v3 endpoints, keyed by method and path
+var EndpointWeights = map[string]EndpointWeight{
+	"GET /api/v3/ping":         {Weight: 1},
+	"GET /api/v3/time":         {Weight: 1},
+	"GET /api/v3/exchangeInfo": {Weight: 20},
+	"GET /api/v3/depth": {DefaultLimit: 100, LimitWeights: []LimitWeight{
+		{MaxLimit: 100, Weight: 5},
+		{MaxLimit: 500, Weight: 25},
+		{MaxLimit: 1000, Weight: 50},
+		{MaxLimit: 5000, Weight: 250},
+	}},
+	"GET /api/v3/trades":                   {Weight: 25},
+	"GET /api/v3/historicalTrades":         {Weight: 25},
+	"GET /api/v3/aggTrades":                {Weight: 2},
+	"GET /api/v3/klines":                   {Weight: 2},
+	"GET /api/v3/uiKlines":                 {Weight: 2},
+	"GET /api/v3/avgPrice":                 {Weight: 2},
+	"GET /api/v3/ticker/24hr":              {Weight: 2},
+	"GET /api/v3/ticker/price":             {Weight: 2, NoSymbolWeight: 4},
+	"GET /api/v3/ticker/bookTicker":        {Weight: 2, NoSymbolWeight: 4},
+	"GET /api/v3/ticker":                   {Weight: 4},
+	"POST /api/v3/order":                   {Weight: 1},
+	"POST /api/v3/order/test":              {Weight: 1},
+	"GET /api/v3/order":                    {Weight: 4},
+	"DELETE /api/v3/order":                 {Weight: 1},
+	"PUT /api/v3/order/amend/keepPriority": {Weight: 4},
+	"DELETE /api/v3/openOrders":            {Weight: 1},
+	"GET /api/v3/openOrders":               {Weight: 6},
+	"GET /api/v3/allOrders":                {Weight: 20},
+	"POST /api/v3/order/oco":               {Weight: 1},
+	"GET /api/v3/orderList":                {Weight: 4},
+	"GET /api/v3/allOrderList":             {Weight: 20},
+	"GET /api/v3/openOrderList":            {Weight: 6},
+	"GET /api/v3/account":                  {Weight: 20},
+	"GET /api/v3/myTrades":                 {Weight: 20},
+	"GET /api/v3/rateLimit/order":          {Weight: 40},
+	"POST /api/v3/userDataStream":          {Weight: 2},
+	"PUT /api/v3/userDataStream":           {Weight: 2},
+	"DELETE /api/v3/userDataStream":        {Weight: 2},
+}
+
+// EstimateWeight return the request weight of an endpoint called with params, see EndpointWeights
+func (c *Client) EstimateWeight(method, endpoint string, params url.Values) int {
+	w, ok := EndpointWeights[method+" "+endpoint]
+	if !ok {
+		return DefaultEndpointWeight
+	}
+	if w.NoSymbolWeight > 0 && params.Get("symbol") == "" {
+		return w.NoSymbolWeight
+	}
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	return w.weight(limit)
+}
+
+// EstimatedUsedWeight return the request weight used in the current minute,
+// as last reported by the server plus the estimated weight of the requests sent since
+func (c *Client) EstimatedUsedWeight() int64 {
+	return c.usedWeight.get(time.Now())
+}
+
+// weightTracker track the used request weight of the current one minute window
+type weightTracker struct {
+	mu     sync.Mutex
+	window int64
+	used   int64
+}
+
+func (t *weightTracker) reset(now time.Time) {
+	if w := now.Unix() / 60; w != t.window {
+		t.window = w
+		t.used = 0
+	}
+}
+
+func (t *weightTracker) get(now time.Time) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset(now)
+	return t.used
+}
+
+func (t *weightTracker) add(now time.Time, weight int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset(now)
+	t.used += int64(weight)
+}
+
+// update correct the estimation with the used weight reported in the response header
+func (t *weightTracker) update(now time.Time, header http.Header) {
+	if header.Get("X-Mbx-Used-Weight-1m") == "" {
+		return
+	}
+	used, err := strconv.ParseInt(header.Get("X-Mbx-Used-Weight-1m"), 10, 64)
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset(now)
+	t.used = used
+}
@@ -0,0 +1,88 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateWeight(t *testing.T) {
+	c := NewClient("key", "secret")
+	limit := func(l string) url.Values {
+		return url.Values{"limit": []string{l}}
+	}
+	tests := []struct {
+		name     string
+		method   string
+		endpoint string
+		params   url.Values
+		weight   int
+	}{
+		{"depth default limit", http.MethodGet, "/api/v3/depth", url.Values{}, 5},
+		{"depth 100", http.MethodGet, "/api/v3/depth", limit("100"), 5},
+		{"depth 101", http.MethodGet, "/api/v3/depth", limit("101"), 25},
+		{"depth 500", http.MethodGet, "/api/v3/depth", limit("500"), 25},
+		{"depth 1000", http.MethodGet, "/api/v3/depth", limit("1000"), 50},
+		{"depth 5000", http.MethodGet, "/api/v3/depth", limit("5000"), 250},
+		{"depth above max", http.MethodGet, "/api/v3/depth", limit("10000"), 250},
+		{"klines default limit", http.MethodGet, "/api/v3/klines", url.Values{}, 2},
+		{"klines 1000", http.MethodGet, "/api/v3/klines", limit("1000"), 2},
+		{"exchange info", http.MethodGet, "/api/v3/exchangeInfo", nil, 20},
+		{"account", http.MethodGet, "/api/v3/account", nil, 20},
+		{"create order", http.MethodPost, "/api/v3/order", nil, 1},
+		{"get order", http.MethodGet, "/api/v3/order", nil, 4},
+		{"price symbol", http.MethodGet, "/api/v3/ticker/price", url.Values{"symbol": []string{"BTCUSDT"}}, 2},
+		{"price symbols", http.MethodGet, "/api/v3/ticker/price", url.Values{"symbols": []string{`["BTCUSDT","ETHUSDT"]`}}, 4},
+		{"book ticker all", http.MethodGet, "/api/v3/ticker/bookTicker", nil, 4},
+		{"unknown endpoint", http.MethodGet, "/sapi/v1/unknown", nil, DefaultEndpointWeight},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.weight, c.EstimateWeight(tt.method, tt.endpoint, tt.params))
+		})
+	}
+}
+
+func TestEstimatedUsedWeight(t *testing.T) {
+	r := require.New(t)
+	usedWeight := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if usedWeight != "" {
+			w.Header().Set("X-MBX-USED-WEIGHT-1M", usedWeight)
+		}
+		w.Write([]byte(`{"lastUpdateId": 1, "bids": [], "asks": []}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("key", "secret")
+	c.BaseURL = server.URL
+
+	_, err := c.NewDepthService().Symbol("BNBBTC").Limit(500).Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(25), c.EstimatedUsedWeight())
+
+	_, err = c.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(30), c.EstimatedUsedWeight())
+
+	// the weight reported by the server replaces the estimation
+	usedWeight = "120"
+	_, err = c.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+	r.NoError(err)
+	r.Equal(int64(120), c.EstimatedUsedWeight())
+}
+
+func TestWeightTrackerWindow(t *testing.T) {
+	r := require.New(t)
+	var tracker weightTracker
+	now := time.Unix(1700000000, 0).Truncate(time.Minute)
+	tracker.add(now, 10)
+	tracker.add(now.Add(59*time.Second), 5)
+	r.Equal(int64(15), tracker.get(now.Add(59*time.Second)))
+	r.Equal(int64(0), tracker.get(now.Add(time.Minute)))
+}
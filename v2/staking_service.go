@@ -2,6 +2,7 @@ package binance
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
@@ -46,7 +47,7 @@ func (s *StakingProductPositionService) Size(size int32) *StakingProductPosition
 }
 
 // Do sends the request.
-func (s *StakingProductPositionService) Do(ctx context.Context) (*StakingProductPositions, error) {
+func (s *StakingProductPositionService) Do(ctx context.Context, opts ...RequestOption) (*StakingProductPositions, error) {
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/sapi/v1/staking/position",
@@ -65,7 +66,7 @@ func (s *StakingProductPositionService) Do(ctx context.Context) (*StakingProduct
 	if s.size != nil {
 		r.setParam("size", *s.size)
 	}
-	data, err := s.c.callAPI(ctx, r)
+	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +78,9 @@ func (s *StakingProductPositionService) Do(ctx context.Context) (*StakingProduct
 	return res, nil
 }
 
+// GetStakingProductPositionService fetches the staking product positions, see StakingProductPositionService
+type GetStakingProductPositionService = StakingProductPositionService
+
 // StakingProductPositions represents a list of staking product positions.
 type StakingProductPositions []StakingProductPosition
 
@@ -165,7 +169,7 @@ func (s *StakingHistoryService) Size(size int32) *StakingHistoryService {
 }
 
 // Do sends the request.
-func (s *StakingHistoryService) Do(ctx context.Context) (*StakingHistory, error) {
+func (s *StakingHistoryService) Do(ctx context.Context, opts ...RequestOption) (*StakingHistory, error) {
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/sapi/v1/staking/stakingRecord",
@@ -188,7 +192,7 @@ func (s *StakingHistoryService) Do(ctx context.Context) (*StakingHistory, error)
 	if s.size != nil {
 		r.setParam("size", *s.size)
 	}
-	data, err := s.c.callAPI(ctx, r)
+	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -200,6 +204,9 @@ func (s *StakingHistoryService) Do(ctx context.Context) (*StakingHistory, error)
 	return res, nil
 }
 
+// GetStakingHistoryService fetches the staking history, see StakingHistoryService
+type GetStakingHistoryService = StakingHistoryService
+
 // StakingHistory represents a list of staking history transactions.
 type StakingHistory []StakingHistoryTransaction
 
@@ -215,3 +222,240 @@ type StakingHistoryTransaction struct {
 	Type        string `json:"type"`
 	Status      string `json:"status"`
 }
+
+// GetStakingProductListService fetches the available staking products
+type GetStakingProductListService struct {
+	c       *Client
+	product StakingProduct
+	asset   *string
+	current *int32
+	size    *int32
+}
+
+// Product sets the product parameter.
+func (s *GetStakingProductListService) Product(product StakingProduct) *GetStakingProductListService {
+	s.product = product
+	return s
+}
+
+// Asset sets the asset parameter.
+func (s *GetStakingProductListService) Asset(asset string) *GetStakingProductListService {
+	s.asset = &asset
+	return s
+}
+
+// Current sets the current parameter.
+func (s *GetStakingProductListService) Current(current int32) *GetStakingProductListService {
+	s.current = &current
+	return s
+}
+
+// Size sets the size parameter.
+func (s *GetStakingProductListService) Size(size int32) *GetStakingProductListService {
+	s.size = &size
+	return s
+}
+
+// Do sends the request.
+func (s *GetStakingProductListService) Do(ctx context.Context, opts ...RequestOption) (*StakingProducts, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/staking/productList",
+		secType:  secTypeSigned,
+	}
+	r.setParam("product", s.product)
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(StakingProducts)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StakingProducts represents a list of staking products.
+type StakingProducts []StakingProductInfo
+
+// StakingProductInfo represents a staking product.
+type StakingProductInfo struct {
+	ProjectId string               `json:"projectId"`
+	Detail    StakingProductDetail `json:"detail"`
+	Quota     StakingProductQuota  `json:"quota"`
+}
+
+// StakingProductDetail represents the terms of a staking product.
+type StakingProductDetail struct {
+	Asset       string `json:"asset"`
+	RewardAsset string `json:"rewardAsset"`
+	Duration    int64  `json:"duration"`
+	Renewable   bool   `json:"renewable"`
+	APY         string `json:"apy"`
+}
+
+// StakingProductQuota represents the purchase limits of a staking product.
+type StakingProductQuota struct {
+	TotalPersonalQuota string `json:"totalPersonalQuota"`
+	Minimum            string `json:"minimum"`
+}
+
+// PurchaseStakingProductService purchases a staking product
+type PurchaseStakingProductService struct {
+	c         *Client
+	product   StakingProduct
+	productId string
+	amount    string
+	renewable *bool
+}
+
+// Product sets the product parameter.
+func (s *PurchaseStakingProductService) Product(product StakingProduct) *PurchaseStakingProductService {
+	s.product = product
+	return s
+}
+
+// ProductId sets the productId parameter, the ProjectId of a StakingProductInfo.
+func (s *PurchaseStakingProductService) ProductId(productId string) *PurchaseStakingProductService {
+	s.productId = productId
+	return s
+}
+
+// Amount sets the amount parameter.
+func (s *PurchaseStakingProductService) Amount(amount string) *PurchaseStakingProductService {
+	s.amount = amount
+	return s
+}
+
+// Renewable sets the renewable parameter, only for locked staking and locked DeFi staking.
+func (s *PurchaseStakingProductService) Renewable(renewable bool) *PurchaseStakingProductService {
+	s.renewable = &renewable
+	return s
+}
+
+// Do sends the request.
+func (s *PurchaseStakingProductService) Do(ctx context.Context, opts ...RequestOption) (*StakingPurchaseResponse, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/staking/purchase",
+		secType:  secTypeSigned,
+	}
+	r.setParam("product", s.product)
+	r.setParam("productId", s.productId)
+	r.setParam("amount", s.amount)
+	if s.renewable != nil {
+		r.setParam("renewable", *s.renewable)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(StakingPurchaseResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StakingPurchaseResponse represents the response of a staking product purchase.
+type StakingPurchaseResponse struct {
+	PositionId string `json:"positionId"`
+	Success    bool   `json:"success"`
+}
+
+// RedeemStakingProductService redeems a staking product.
+// Locked staking and locked DeFi staking positions are redeemed whole, by positionId, before their end date,
+// flexible DeFi staking is redeemed by amount.
+type RedeemStakingProductService struct {
+	c          *Client
+	product    StakingProduct
+	productId  string
+	positionId *string
+	amount     *string
+}
+
+// Product sets the product parameter.
+func (s *RedeemStakingProductService) Product(product StakingProduct) *RedeemStakingProductService {
+	s.product = product
+	return s
+}
+
+// ProductId sets the productId parameter.
+func (s *RedeemStakingProductService) ProductId(productId string) *RedeemStakingProductService {
+	s.productId = productId
+	return s
+}
+
+// PositionId sets the positionId parameter, required for locked staking and locked DeFi staking.
+func (s *RedeemStakingProductService) PositionId(positionId string) *RedeemStakingProductService {
+	s.positionId = &positionId
+	return s
+}
+
+// Amount sets the amount parameter, required for flexible DeFi staking.
+func (s *RedeemStakingProductService) Amount(amount string) *RedeemStakingProductService {
+	s.amount = &amount
+	return s
+}
+
+func (s *RedeemStakingProductService) validate() error {
+	switch s.product {
+	case StakingProductLockedStaking, StakingProductLockedDeFiStaking:
+		if s.positionId == nil {
+			return fmt.Errorf("%w: positionId is required to redeem %s", ErrInvalidParam, s.product)
+		}
+	case StakingProductFlexibleDeFiStaking:
+		if s.amount == nil {
+			return fmt.Errorf("%w: amount is required to redeem %s", ErrInvalidParam, s.product)
+		}
+	default:
+		return fmt.Errorf("%w: invalid product %q", ErrInvalidParam, s.product)
+	}
+	return nil
+}
+
+// Do sends the request.
+func (s *RedeemStakingProductService) Do(ctx context.Context, opts ...RequestOption) (*StakingRedeemResponse, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/staking/redeem",
+		secType:  secTypeSigned,
+	}
+	r.setParam("product", s.product)
+	r.setParam("productId", s.productId)
+	if s.positionId != nil {
+		r.setParam("positionId", *s.positionId)
+	}
+	if s.amount != nil {
+		r.setParam("amount", *s.amount)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(StakingRedeemResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StakingRedeemResponse represents the response of a staking product redemption.
+type StakingRedeemResponse struct {
+	Success bool `json:"success"`
+}
@@ -196,3 +196,110 @@ func (s *stakingServiceTestSuite) assertStakingHistoryTransactionEqual(e, a *Sta
 	r.Equal(e.Type, a.Type, "Type")
 	r.Equal(e.Status, a.Status, "Status")
 }
+
+func (s *stakingServiceTestSuite) TestGetStakingProductList() {
+	data := []byte(`[
+	  {
+		"projectId": "Axs*90",
+		"detail": {
+		  "asset": "AXS",
+		  "rewardAsset": "AXS",
+		  "duration": 90,
+		  "renewable": true,
+		  "apy": "1.2069"
+		},
+		"quota": {
+		  "totalPersonalQuota": "2",
+		  "minimum": "0.001"
+		}
+	  }
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"product": StakingProductLockedStaking,
+			"asset":   "AXS",
+			"current": int32(1),
+			"size":    int32(10),
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	products, err := s.client.NewGetStakingProductListService().Product(StakingProductLockedStaking).
+		Asset("AXS").Current(1).Size(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&StakingProducts{
+		{
+			ProjectId: "Axs*90",
+			Detail: StakingProductDetail{
+				Asset:       "AXS",
+				RewardAsset: "AXS",
+				Duration:    90,
+				Renewable:   true,
+				APY:         "1.2069",
+			},
+			Quota: StakingProductQuota{
+				TotalPersonalQuota: "2",
+				Minimum:            "0.001",
+			},
+		},
+	}, products)
+}
+
+func (s *stakingServiceTestSuite) TestPurchaseStakingProduct() {
+	data := []byte(`{"positionId": "12345", "success": true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"product":   StakingProductLockedStaking,
+			"productId": "Axs*90",
+			"amount":    "1.5",
+			"renewable": true,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewPurchaseStakingProductService().Product(StakingProductLockedStaking).
+		ProductId("Axs*90").Amount("1.5").Renewable(true).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&StakingPurchaseResponse{PositionId: "12345", Success: true}, res)
+}
+
+func (s *stakingServiceTestSuite) TestRedeemStakingProduct() {
+	data := []byte(`{"success": true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"product":    StakingProductLockedStaking,
+			"productId":  "Axs*90",
+			"positionId": "12345",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewRedeemStakingProductService().Product(StakingProductLockedStaking).
+		ProductId("Axs*90").PositionId("12345").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.True(res.Success)
+}
+
+func (s *stakingServiceTestSuite) TestRedeemStakingProductInvalidParams() {
+	r := s.r()
+	_, err := s.client.NewRedeemStakingProductService().Product(StakingProductLockedDeFiStaking).
+		ProductId("Axs*90").Amount("1").Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewRedeemStakingProductService().Product(StakingProductFlexibleDeFiStaking).
+		ProductId("Axs*90").PositionId("12345").Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+	_, err = s.client.NewRedeemStakingProductService().ProductId("Axs*90").Do(newContext())
+	r.ErrorIs(err, ErrInvalidParam)
+}
@@ -0,0 +1,174 @@
+package binance
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// SymbolEventType define the type of a change between two exchange info snapshots
+type SymbolEventType string
+
+// SymbolEventType values
+const (
+	SymbolEventTypeListed        SymbolEventType = "SYMBOL_LISTED"
+	SymbolEventTypeDelisted      SymbolEventType = "SYMBOL_DELISTED"
+	SymbolEventTypeStatusChanged SymbolEventType = "SYMBOL_STATUS_CHANGED"
+	SymbolEventTypeFilterChanged SymbolEventType = "FILTER_CHANGED"
+)
+
+// SymbolEvent define a change of a symbol between two exchange info snapshots
+type SymbolEvent struct {
+	Type   SymbolEventType
+	Symbol string
+	// Old is nil when the symbol is listed, New is nil when it is delisted
+	Old *Symbol
+	New *Symbol
+	// FilterType, OldFilter and NewFilter are only set for SymbolEventTypeFilterChanged,
+	// OldFilter is nil for an added filter and NewFilter is nil for a removed one
+	FilterType string
+	OldFilter  map[string]interface{}
+	NewFilter  map[string]interface{}
+}
+
+// DiffSymbols return the events turning the prev symbols into the next ones.
+// Listings, status and filter changes follow the order of the next symbols, delistings come last.
+func DiffSymbols(prev, next []Symbol) []*SymbolEvent {
+	prevBySymbol := make(map[string]*Symbol, len(prev))
+	for i := range prev {
+		prevBySymbol[prev[i].Symbol] = &prev[i]
+	}
+	nextBySymbol := make(map[string]bool, len(next))
+	var events []*SymbolEvent
+	for i := range next {
+		n := &next[i]
+		nextBySymbol[n.Symbol] = true
+		o, ok := prevBySymbol[n.Symbol]
+		if !ok {
+			events = append(events, &SymbolEvent{Type: SymbolEventTypeListed, Symbol: n.Symbol, New: n})
+			continue
+		}
+		if o.Status != n.Status {
+			events = append(events, &SymbolEvent{Type: SymbolEventTypeStatusChanged, Symbol: n.Symbol, Old: o, New: n})
+		}
+		events = append(events, diffFilters(o, n)...)
+	}
+	for i := range prev {
+		if o := &prev[i]; !nextBySymbol[o.Symbol] {
+			events = append(events, &SymbolEvent{Type: SymbolEventTypeDelisted, Symbol: o.Symbol, Old: o})
+		}
+	}
+	return events
+}
+
+// diffFilters return the filter changes of a symbol, keyed by filterType
+func diffFilters(o, n *Symbol) []*SymbolEvent {
+	filterType := func(f map[string]interface{}) string {
+		t, _ := f["filterType"].(string)
+		return t
+	}
+	prevFilters := make(map[string]map[string]interface{}, len(o.Filters))
+	for _, f := range o.Filters {
+		prevFilters[filterType(f)] = f
+	}
+	nextFilters := make(map[string]bool, len(n.Filters))
+	var events []*SymbolEvent
+	for _, f := range n.Filters {
+		t := filterType(f)
+		nextFilters[t] = true
+		if of, ok := prevFilters[t]; !ok || !reflect.DeepEqual(of, f) {
+			events = append(events, &SymbolEvent{
+				Type: SymbolEventTypeFilterChanged, Symbol: n.Symbol, Old: o, New: n,
+				FilterType: t, OldFilter: of, NewFilter: f,
+			})
+		}
+	}
+	for _, f := range o.Filters {
+		if t := filterType(f); !nextFilters[t] {
+			events = append(events, &SymbolEvent{
+				Type: SymbolEventTypeFilterChanged, Symbol: n.Symbol, Old: o, New: n,
+				FilterType: t, OldFilter: f,
+			})
+		}
+	}
+	return events
+}
+
+// DefaultSymbolWatchInterval is the poll interval of a SymbolWatcher created without a positive interval
+const DefaultSymbolWatchInterval = time.Minute
+
+// SymbolEventHandler handle a symbol change
+type SymbolEventHandler func(event *SymbolEvent)
+
+// SymbolWatcher poll the exchange info and report the symbols listed, delisted,
+// or whose status or filters changed since the previous poll.
+// The first poll only records the symbols, it does not report every symbol as listed.
+type SymbolWatcher struct {
+	c          *Client
+	interval   time.Duration
+	handler    SymbolEventHandler
+	errHandler ErrHandler
+
+	mu      sync.Mutex
+	symbols []Symbol
+	polled  bool
+}
+
+// NewSymbolWatcher init a symbol watcher polling the exchange info every interval,
+// the errors of the polls are passed to errHandler
+func (c *Client) NewSymbolWatcher(interval time.Duration, handler SymbolEventHandler, errHandler ErrHandler) *SymbolWatcher {
+	if interval <= 0 {
+		interval = DefaultSymbolWatchInterval
+	}
+	return &SymbolWatcher{
+		c:          c,
+		interval:   interval,
+		handler:    handler,
+		errHandler: errHandler,
+	}
+}
+
+// Symbols return the symbols of the last poll
+func (w *SymbolWatcher) Symbols() []Symbol {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]Symbol{}, w.symbols...)
+}
+
+// Poll fetch the exchange info once and report its changes since the previous poll
+func (w *SymbolWatcher) Poll(ctx context.Context, opts ...RequestOption) error {
+	info, err := w.c.NewExchangeInfoService().Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	var events []*SymbolEvent
+	if w.polled {
+		events = DiffSymbols(w.symbols, info.Symbols)
+	}
+	w.symbols = info.Symbols
+	w.polled = true
+	w.mu.Unlock()
+
+	for _, e := range events {
+		w.handler(e)
+	}
+	return nil
+}
+
+// Run poll the exchange info every interval until ctx is done, it returns the error of ctx
+func (w *SymbolWatcher) Run(ctx context.Context, opts ...RequestOption) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.Poll(ctx, opts...); err != nil && ctx.Err() == nil {
+			w.errHandler(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var symbolWatcherSnapshots = []string{`{
+	"symbols": [
+		{
+			"symbol": "BTCUSDT",
+			"status": "TRADING",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.01000000"},
+				{"filterType": "LOT_SIZE", "minQty": "0.00001000", "maxQty": "9000.00000000", "stepSize": "0.00001000"},
+				{"filterType": "ICEBERG_PARTS", "limit": 10}
+			]
+		},
+		{
+			"symbol": "ETHUSDT",
+			"status": "TRADING",
+			"filters": [{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.01000000"}]
+		},
+		{
+			"symbol": "LUNAUSDT",
+			"status": "BREAK",
+			"filters": []
+		}
+	]
+}`, `{
+	"symbols": [
+		{
+			"symbol": "BTCUSDT",
+			"status": "TRADING",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.10000000"},
+				{"filterType": "LOT_SIZE", "minQty": "0.00001000", "maxQty": "9000.00000000", "stepSize": "0.00001000"},
+				{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": 200}
+			]
+		},
+		{
+			"symbol": "ETHUSDT",
+			"status": "HALT",
+			"filters": [{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.01000000"}]
+		},
+		{
+			"symbol": "NEWUSDT",
+			"status": "PRE_TRADING",
+			"filters": []
+		}
+	]
+}`}
+
+func TestDiffSymbols(t *testing.T) {
+	r := require.New(t)
+	var prev, next ExchangeInfo
+	r.NoError(json.Unmarshal([]byte(symbolWatcherSnapshots[0]), &prev))
+	r.NoError(json.Unmarshal([]byte(symbolWatcherSnapshots[1]), &next))
+
+	events := DiffSymbols(prev.Symbols, next.Symbols)
+	r.Len(events, 6)
+
+	r.Equal(SymbolEventTypeFilterChanged, events[0].Type)
+	r.Equal("BTCUSDT", events[0].Symbol)
+	r.Equal("PRICE_FILTER", events[0].FilterType)
+	r.Equal("0.01000000", events[0].OldFilter["tickSize"])
+	r.Equal("0.10000000", events[0].NewFilter["tickSize"])
+
+	r.Equal(SymbolEventTypeFilterChanged, events[1].Type)
+	r.Equal("MAX_NUM_ORDERS", events[1].FilterType)
+	r.Nil(events[1].OldFilter)
+	r.NotNil(events[1].NewFilter)
+
+	r.Equal(SymbolEventTypeFilterChanged, events[2].Type)
+	r.Equal("ICEBERG_PARTS", events[2].FilterType)
+	r.NotNil(events[2].OldFilter)
+	r.Nil(events[2].NewFilter)
+
+	r.Equal(SymbolEventTypeStatusChanged, events[3].Type)
+	r.Equal("ETHUSDT", events[3].Symbol)
+	r.Equal("TRADING", events[3].Old.Status)
+	r.Equal("HALT", events[3].New.Status)
+
+	r.Equal(SymbolEventTypeListed, events[4].Type)
+	r.Equal("NEWUSDT", events[4].Symbol)
+	r.Nil(events[4].Old)
+	r.Equal("PRE_TRADING", events[4].New.Status)
+
+	r.Equal(SymbolEventTypeDelisted, events[5].Type)
+	r.Equal("LUNAUSDT", events[5].Symbol)
+	r.Nil(events[5].New)
+
+	r.Empty(DiffSymbols(next.Symbols, next.Symbols))
+}
+
+func TestSymbolWatcherPoll(t *testing.T) {
+	r := require.New(t)
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/api/v3/exchangeInfo", req.URL.Path)
+		n := atomic.AddInt32(&calls, 1)
+		if n > int32(len(symbolWatcherSnapshots)) {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"code":-1000,"msg":"unknown"}`)
+			return
+		}
+		fmt.Fprint(w, symbolWatcherSnapshots[n-1])
+	}))
+	defer server.Close()
+	c := NewClient("", "")
+	c.BaseURL = server.URL
+
+	var events []*SymbolEvent
+	watcher := c.NewSymbolWatcher(0, func(event *SymbolEvent) {
+		events = append(events, event)
+	}, func(err error) {})
+
+	r.NoError(watcher.Poll(context.Background()))
+	r.Empty(events, "the first poll only records the symbols")
+	r.Len(watcher.Symbols(), 3)
+
+	r.NoError(watcher.Poll(context.Background()))
+	r.Len(events, 6)
+	r.Equal("NEWUSDT", watcher.Symbols()[2].Symbol)
+
+	r.Error(watcher.Poll(context.Background()))
+	r.Len(watcher.Symbols(), 3, "a failed poll keeps the last symbols")
+}
@@ -9,8 +9,9 @@ import (
 
 // ListBookTickersService list best price/qty on the order book for a symbol or symbols
 type ListBookTickersService struct {
-	c      *Client
-	symbol *string
+	c       *Client
+	symbol  *string
+	symbols []string
 }
 
 // Symbol set symbol
@@ -19,6 +20,12 @@ func (s *ListBookTickersService) Symbol(symbol string) *ListBookTickersService {
 	return s
 }
 
+// Symbols set symbols, it is ignored when symbol is set
+func (s *ListBookTickersService) Symbols(symbols []string) *ListBookTickersService {
+	s.symbols = symbols
+	return s
+}
+
 // Do send request
 func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption) (res []*BookTicker, err error) {
 	r := &request{
@@ -27,12 +34,14 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
+	} else if len(s.symbols) != 0 {
+		r.setParam("symbols", s.symbols)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
-	data = common.ToJSONList(data)
 	if err != nil {
 		return []*BookTicker{}, err
 	}
+	data = common.ToJSONList(data)
 	res = make([]*BookTicker, 0)
 	err = json.Unmarshal(data, &res)
 	if err != nil {
@@ -71,9 +80,8 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
-	} else if s.symbols != nil {
-		s, _ := json.Marshal(s.symbols)
-		r.setParam("symbols", string(s))
+	} else if len(s.symbols) != 0 {
+		r.setParam("symbols", s.symbols)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -113,7 +121,7 @@ func (s *ListPriceChangeStatsService) Symbols(symbols []string) *ListPriceChange
 	return s
 }
 
-// Symbols set symbols
+// Symbols set symbols, it is ignored when symbol is set
 func (s *ListPricesService) Symbols(symbols []string) *ListPricesService {
 	s.symbols = symbols
 	return s
@@ -201,7 +209,10 @@ func (s *AveragePriceService) Do(ctx context.Context, opts ...RequestOption) (re
 	return res, nil
 }
 
-// AvgPrice define average price
+// GetAveragePriceService show current average price for a symbol, see AveragePriceService
+type GetAveragePriceService = AveragePriceService
+
+// AvgPrice define average price, calculated over the last Mins minutes
 type AvgPrice struct {
 	Mins  int64  `json:"mins"`
 	Price string `json:"price"`
@@ -267,9 +278,8 @@ func (s *ListSymbolTickerService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
-	} else if s.symbols != nil {
-		s, _ := json.Marshal(s.symbols)
-		r.setParam("symbols", string(s))
+	} else if len(s.symbols) != 0 {
+		r.setParam("symbols", s.symbols)
 	}
 
 	if s.windowSize != nil {
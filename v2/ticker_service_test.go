@@ -93,6 +93,44 @@ func (s *tickerServiceTestSuite) TestSingleBookTicker() {
 	s.assertBookTickerEqual(e, tickers[0])
 }
 
+func (s *tickerServiceTestSuite) TestListBookTickersForMultipleSymbols() {
+	data := []byte(`[
+        {
+            "symbol": "LTCBTC",
+            "bidPrice": "4.00000000",
+            "bidQty": "431.00000000",
+            "askPrice": "4.00000200",
+            "askQty": "9.00000000"
+        },
+        {
+            "symbol": "ETHBTC",
+            "bidPrice": "0.07946700",
+            "bidQty": "9.00000000",
+            "askPrice": "100000.00000000",
+            "askQty": "1000.00000000"
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbols", `["LTCBTC","ETHBTC"]`)
+		s.assertRequestEqual(e, r)
+	})
+
+	tickers, err := s.client.NewListBookTickersService().Symbols([]string{"LTCBTC", "ETHBTC"}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(tickers, 2)
+	s.assertBookTickerEqual(&BookTicker{
+		Symbol:      "ETHBTC",
+		BidPrice:    "0.07946700",
+		BidQuantity: "9.00000000",
+		AskPrice:    "100000.00000000",
+		AskQuantity: "1000.00000000",
+	}, tickers[1])
+}
+
 func (s *tickerServiceTestSuite) assertBookTickerEqual(e, a *BookTicker) {
 	r := s.r()
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
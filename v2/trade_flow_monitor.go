@@ -0,0 +1,287 @@
+package binance
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// tradeFlowBuckets is the number of ring buckets kept for each rolling window
+const tradeFlowBuckets = 20
+
+// DefaultTradeFlowWindows are the rolling windows used when none are given to NewTradeFlowMonitor
+var DefaultTradeFlowWindows = []time.Duration{time.Second, 10 * time.Second, time.Minute}
+
+// TradeFlowStats define rolling trade statistics of a symbol over a window
+type TradeFlowStats struct {
+	Window       time.Duration
+	TradeCount   int64
+	BuyVolume    float64
+	SellVolume   float64
+	BuyNotional  float64
+	SellNotional float64
+	// VWAP is the volume weighted average price, zero when there is no volume in the window
+	VWAP float64
+	// Imbalance is (buy - sell) / (buy + sell) volume, in the range [-1, 1]
+	Imbalance float64
+}
+
+// Volume return the total traded base volume
+func (s *TradeFlowStats) Volume() float64 {
+	return s.BuyVolume + s.SellVolume
+}
+
+// Notional return the total traded quote volume
+func (s *TradeFlowStats) Notional() float64 {
+	return s.BuyNotional + s.SellNotional
+}
+
+// TradeFlowCondition reports whether the stats of a window cross a threshold
+type TradeFlowCondition func(stats *TradeFlowStats) bool
+
+// TradeFlowAlertHandler handle a threshold crossed by a symbol
+type TradeFlowAlertHandler func(symbol string, stats TradeFlowStats)
+
+type tradeFlowBucket struct {
+	count        int64
+	buyVolume    float64
+	sellVolume   float64
+	buyNotional  float64
+	sellNotional float64
+}
+
+type tradeFlowWindow struct {
+	size    time.Duration
+	res     int64 // bucket resolution in milliseconds
+	head    int64 // absolute index of the newest bucket
+	buckets []tradeFlowBucket
+}
+
+func newTradeFlowWindow(size time.Duration) tradeFlowWindow {
+	ms := size.Milliseconds()
+	res := ms / tradeFlowBuckets
+	if res < 1 {
+		res = 1
+	}
+	n := (ms + res - 1) / res
+	if n < 1 {
+		n = 1
+	}
+	return tradeFlowWindow{
+		size:    size,
+		res:     res,
+		buckets: make([]tradeFlowBucket, n),
+	}
+}
+
+// advance moves the newest bucket to idx, clearing the buckets that fall out of the window
+func (w *tradeFlowWindow) advance(idx int64) {
+	if idx <= w.head {
+		return
+	}
+	n := int64(len(w.buckets))
+	if idx-w.head >= n {
+		for i := range w.buckets {
+			w.buckets[i] = tradeFlowBucket{}
+		}
+	} else {
+		for i := w.head + 1; i <= idx; i++ {
+			w.buckets[i%n] = tradeFlowBucket{}
+		}
+	}
+	w.head = idx
+}
+
+func (w *tradeFlowWindow) add(ts int64, isBuy bool, qty, notional float64) {
+	idx := ts / w.res
+	w.advance(idx)
+	n := int64(len(w.buckets))
+	if idx <= w.head-n {
+		// older than the window, nothing to account for
+		return
+	}
+	b := &w.buckets[idx%n]
+	b.count++
+	if isBuy {
+		b.buyVolume += qty
+		b.buyNotional += notional
+	} else {
+		b.sellVolume += qty
+		b.sellNotional += notional
+	}
+}
+
+func (w *tradeFlowWindow) stats(stats *TradeFlowStats) {
+	*stats = TradeFlowStats{Window: w.size}
+	for i := range w.buckets {
+		b := &w.buckets[i]
+		stats.TradeCount += b.count
+		stats.BuyVolume += b.buyVolume
+		stats.SellVolume += b.sellVolume
+		stats.BuyNotional += b.buyNotional
+		stats.SellNotional += b.sellNotional
+	}
+	if volume := stats.Volume(); volume > 0 {
+		stats.VWAP = stats.Notional() / volume
+		stats.Imbalance = (stats.BuyVolume - stats.SellVolume) / volume
+	}
+}
+
+type tradeFlowThreshold struct {
+	window    int
+	condition TradeFlowCondition
+	handler   TradeFlowAlertHandler
+}
+
+type tradeFlowSymbol struct {
+	last      int64 // newest trade time in milliseconds
+	windows   []tradeFlowWindow
+	triggered []bool
+}
+
+type tradeFlowAlert struct {
+	handler TradeFlowAlertHandler
+	stats   TradeFlowStats
+}
+
+// TradeFlowMonitor maintain rolling window trade statistics per symbol from aggregate trade events.
+// Windows are bucketed ring buffers keyed on the exchange trade time, so pushing a trade does not allocate
+// once a symbol has been seen.
+type TradeFlowMonitor struct {
+	mu         sync.Mutex
+	windows    []time.Duration
+	symbols    map[string]*tradeFlowSymbol
+	thresholds []tradeFlowThreshold
+	stats      TradeFlowStats
+	now        func() time.Time
+}
+
+// NewTradeFlowMonitor init a trade flow monitor with the given rolling windows,
+// DefaultTradeFlowWindows is used when no window is given
+func NewTradeFlowMonitor(windows ...time.Duration) *TradeFlowMonitor {
+	if len(windows) == 0 {
+		windows = DefaultTradeFlowWindows
+	}
+	return &TradeFlowMonitor{
+		windows: append([]time.Duration{}, windows...),
+		symbols: make(map[string]*tradeFlowSymbol),
+		now:     time.Now,
+	}
+}
+
+// Windows return the rolling windows of the monitor, in the order of the stats returned by Snapshot
+func (m *TradeFlowMonitor) Windows() []time.Duration {
+	return append([]time.Duration{}, m.windows...)
+}
+
+// OnThreshold register handler to be called when condition becomes true for the stats of window.
+// The handler is called once each time the condition goes from false to true for a symbol.
+// window must be one of the windows of the monitor, otherwise the threshold is ignored.
+func (m *TradeFlowMonitor) OnThreshold(window time.Duration, condition TradeFlowCondition, handler TradeFlowAlertHandler) *TradeFlowMonitor {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, w := range m.windows {
+		if w == window {
+			m.thresholds = append(m.thresholds, tradeFlowThreshold{
+				window:    i,
+				condition: condition,
+				handler:   handler,
+			})
+			for _, s := range m.symbols {
+				s.triggered = append(s.triggered, false)
+			}
+			break
+		}
+	}
+	return m
+}
+
+// Push add an aggregate trade to the statistics of its symbol
+func (m *TradeFlowMonitor) Push(event *WsAggTradeEvent) error {
+	price, err := strconv.ParseFloat(event.Price, 64)
+	if err != nil {
+		return err
+	}
+	qty, err := strconv.ParseFloat(event.Quantity, 64)
+	if err != nil {
+		return err
+	}
+	// the buyer being the maker means the seller was the aggressor
+	isBuy := !event.IsBuyerMaker
+
+	var alerts []tradeFlowAlert
+	m.mu.Lock()
+	s := m.symbol(event.Symbol)
+	if event.TradeTime > s.last {
+		s.last = event.TradeTime
+	}
+	for i := range s.windows {
+		s.windows[i].add(event.TradeTime, isBuy, qty, price*qty)
+	}
+	for i, t := range m.thresholds {
+		s.windows[t.window].stats(&m.stats)
+		hit := t.condition(&m.stats)
+		if hit && !s.triggered[i] {
+			alerts = append(alerts, tradeFlowAlert{handler: t.handler, stats: m.stats})
+		}
+		s.triggered[i] = hit
+	}
+	m.mu.Unlock()
+
+	for _, a := range alerts {
+		a.handler(event.Symbol, a.stats)
+	}
+	return nil
+}
+
+func (m *TradeFlowMonitor) symbol(symbol string) *tradeFlowSymbol {
+	s, ok := m.symbols[symbol]
+	if !ok {
+		s = &tradeFlowSymbol{
+			windows:   make([]tradeFlowWindow, len(m.windows)),
+			triggered: make([]bool, len(m.thresholds)),
+		}
+		for i, w := range m.windows {
+			s.windows[i] = newTradeFlowWindow(w)
+		}
+		m.symbols[symbol] = s
+	}
+	return s
+}
+
+// Snapshot return the statistics of symbol for each window of the monitor,
+// windows are first expired up to the current time so a quiet symbol decays to zero.
+// It returns nil if no trade of symbol has been pushed.
+func (m *TradeFlowMonitor) Snapshot(symbol string) []TradeFlowStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.symbols[symbol]
+	if !ok {
+		return nil
+	}
+	ts := FormatTimestamp(m.now())
+	if ts < s.last {
+		ts = s.last
+	}
+	res := make([]TradeFlowStats, len(s.windows))
+	for i := range s.windows {
+		w := &s.windows[i]
+		w.advance(ts / w.res)
+		w.stats(&res[i])
+	}
+	return res
+}
+
+// Handler return a WsAggTradeHandler feeding the monitor, parsing errors are passed to errHandler
+func (m *TradeFlowMonitor) Handler(errHandler ErrHandler) WsAggTradeHandler {
+	return func(event *WsAggTradeEvent) {
+		if err := m.Push(event); err != nil {
+			errHandler(err)
+		}
+	}
+}
+
+// Serve feed the monitor with the aggregate trade stream of symbol
+func (m *TradeFlowMonitor) Serve(symbol string, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsAggTradeServe(symbol, m.Handler(errHandler), errHandler, opts...)
+}
@@ -0,0 +1,144 @@
+package binance
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type tradeFlowMonitorTestSuite struct {
+	suite.Suite
+	now time.Time
+	m   *TradeFlowMonitor
+}
+
+func TestTradeFlowMonitor(t *testing.T) {
+	suite.Run(t, new(tradeFlowMonitorTestSuite))
+}
+
+func (s *tradeFlowMonitorTestSuite) SetupTest() {
+	s.now = time.Unix(1600000000, 0)
+	s.m = NewTradeFlowMonitor(time.Second, 10*time.Second)
+	s.m.now = func() time.Time { return s.now }
+}
+
+func (s *tradeFlowMonitorTestSuite) push(symbol string, offset time.Duration, price, qty string, isBuyerMaker bool) {
+	err := s.m.Push(&WsAggTradeEvent{
+		Symbol:       symbol,
+		Price:        price,
+		Quantity:     qty,
+		TradeTime:    FormatTimestamp(s.now.Add(offset)),
+		IsBuyerMaker: isBuyerMaker,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *tradeFlowMonitorTestSuite) TestSnapshot() {
+	s.push("BTCUSDT", 0, "100", "1", false)
+	s.push("BTCUSDT", 100*time.Millisecond, "110", "3", true)
+	s.push("ETHUSDT", 0, "10", "5", false)
+
+	res := s.m.Snapshot("BTCUSDT")
+	r := s.Require()
+	r.Len(res, 2)
+	for i, w := range []time.Duration{time.Second, 10 * time.Second} {
+		r.Equal(w, res[i].Window)
+		r.Equal(int64(2), res[i].TradeCount)
+		r.InDelta(1, res[i].BuyVolume, 1e-9)
+		r.InDelta(3, res[i].SellVolume, 1e-9)
+		r.InDelta(100, res[i].BuyNotional, 1e-9)
+		r.InDelta(330, res[i].SellNotional, 1e-9)
+		r.InDelta(107.5, res[i].VWAP, 1e-9)
+		r.InDelta(-0.5, res[i].Imbalance, 1e-9)
+	}
+	r.Nil(s.m.Snapshot("BNBUSDT"))
+}
+
+func (s *tradeFlowMonitorTestSuite) TestSnapshotExpire() {
+	s.push("BTCUSDT", 0, "100", "1", false)
+	s.now = s.now.Add(2 * time.Second)
+	s.push("BTCUSDT", 0, "200", "1", false)
+
+	res := s.m.Snapshot("BTCUSDT")
+	r := s.Require()
+	r.Equal(int64(1), res[0].TradeCount)
+	r.InDelta(200, res[0].VWAP, 1e-9)
+	r.Equal(int64(2), res[1].TradeCount)
+	r.InDelta(150, res[1].VWAP, 1e-9)
+
+	// quiet symbol decays to zero
+	s.now = s.now.Add(time.Minute)
+	res = s.m.Snapshot("BTCUSDT")
+	r.Equal(int64(0), res[0].TradeCount)
+	r.Equal(int64(0), res[1].TradeCount)
+	r.Zero(res[1].VWAP)
+}
+
+func (s *tradeFlowMonitorTestSuite) TestOnThreshold() {
+	var alerts []TradeFlowStats
+	s.m.OnThreshold(10*time.Second, func(stats *TradeFlowStats) bool {
+		return stats.SellNotional > 1000
+	}, func(symbol string, stats TradeFlowStats) {
+		s.Equal("BTCUSDT", symbol)
+		alerts = append(alerts, stats)
+	})
+
+	s.push("BTCUSDT", 0, "100", "6", true)
+	s.Len(alerts, 0)
+	s.push("BTCUSDT", time.Second, "100", "6", true)
+	s.Require().Len(alerts, 1)
+	s.InDelta(1200, alerts[0].SellNotional, 1e-9)
+	// still above the threshold, no new alert
+	s.push("BTCUSDT", 2*time.Second, "100", "1", true)
+	s.Len(alerts, 1)
+	// falls back below once the first trades expire, then crosses again
+	s.push("BTCUSDT", 11*time.Second, "100", "1", true)
+	s.Len(alerts, 1)
+	s.push("BTCUSDT", 12*time.Second, "100", "20", true)
+	s.Len(alerts, 2)
+}
+
+func (s *tradeFlowMonitorTestSuite) TestHandlerError() {
+	var errs []error
+	h := s.m.Handler(func(err error) {
+		errs = append(errs, err)
+	})
+	h(&WsAggTradeEvent{Symbol: "BTCUSDT", Price: "abc", Quantity: "1"})
+	s.Require().Len(errs, 1)
+	var numErr *strconv.NumError
+	s.True(errors.As(errs[0], &numErr))
+	s.Nil(s.m.Snapshot("BTCUSDT"))
+}
+
+func BenchmarkTradeFlowMonitorPush(b *testing.B) {
+	const n = 100000
+	symbols := []string{"BTCUSDT", "ETHUSDT", "BNBUSDT"}
+	start := FormatTimestamp(time.Unix(1600000000, 0))
+	events := make([]WsAggTradeEvent, n)
+	for i := range events {
+		events[i] = WsAggTradeEvent{
+			Symbol:       symbols[i%len(symbols)],
+			Price:        strconv.FormatFloat(20000+float64(i%100), 'f', 2, 64),
+			Quantity:     "0.015",
+			TradeTime:    start + int64(i/10),
+			IsBuyerMaker: i%3 == 0,
+		}
+	}
+	m := NewTradeFlowMonitor()
+	m.OnThreshold(10*time.Second, func(stats *TradeFlowStats) bool {
+		return stats.SellNotional > 1e6
+	}, func(symbol string, stats TradeFlowStats) {})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range events {
+			if err := m.Push(&events[j]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
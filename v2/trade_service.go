@@ -2,10 +2,12 @@ package binance
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
-// ListTradesService list trades
+// ListTradesService list the trades of the account on a symbol, page through them by
+// setting FromID to the last trade id plus one
 type ListTradesService struct {
 	c         *Client
 	symbol    string
@@ -40,20 +42,30 @@ func (s *ListTradesService) Limit(limit int) *ListTradesService {
 	return s
 }
 
-// FromID set fromID
+// FromID set fromID, it cannot be sent with orderId
 func (s *ListTradesService) FromID(fromID int64) *ListTradesService {
 	s.fromID = &fromID
 	return s
 }
 
+// OrderID set orderId, it cannot be sent with fromId
+func (s *ListTradesService) OrderID(orderID int64) *ListTradesService {
+	s.orderId = &orderID
+	return s
+}
+
 // OrderId set OrderId
+//
+// Deprecated: use OrderID instead
 func (s *ListTradesService) OrderId(OrderId int64) *ListTradesService {
-	s.orderId = &OrderId
-	return s
+	return s.OrderID(OrderId)
 }
 
 // Do send request
 func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*TradeV3, err error) {
+	if s.fromID != nil && s.orderId != nil {
+		return []*TradeV3{}, fmt.Errorf("%w: fromId cannot be sent with orderId", ErrInvalidParam)
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/myTrades",
@@ -87,6 +99,9 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// GetMyTradesService list the trades of the account, see ListTradesService
+type GetMyTradesService = ListTradesService
+
 // HistoricalTradesService trades
 type HistoricalTradesService struct {
 	c      *Client
@@ -210,8 +225,17 @@ func (s *AggTradesService) Limit(limit int) *AggTradesService {
 	return s
 }
 
+// GetAggTradesService list compressed aggregate trades, see AggTradesService
+type GetAggTradesService = AggTradesService
+
 // Do send request
 func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*AggTrade, err error) {
+	if s.fromID != nil && (s.startTime != nil || s.endTime != nil) {
+		return []*AggTrade{}, fmt.Errorf("%w: fromId cannot be sent with startTime or endTime", ErrInvalidParam)
+	}
+	if s.startTime != nil && s.endTime != nil && *s.endTime-*s.startTime >= aggTradesMaxWindow {
+		return []*AggTrade{}, fmt.Errorf("%w: startTime to endTime must be less than 1 hour", ErrInvalidParam)
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/api/v3/aggTrades",
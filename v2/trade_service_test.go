@@ -75,6 +75,29 @@ func (s *tradeServiceTestSuite) TestListTrades() {
 	s.assertTradeV3Equal(e, trades[0])
 }
 
+func (s *tradeServiceTestSuite) TestListTradesByOrderID() {
+	data := []byte(`[{"id": 28457, "symbol": "BNBBTC", "orderId": 100234, "orderListId": -1}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":  "BNBBTC",
+			"orderId": 100234,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	trades, err := s.client.NewListTradesService().Symbol("BNBBTC").OrderID(100234).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(trades, 1)
+	s.r().Equal(int64(100234), trades[0].OrderID)
+	s.r().Equal(int64(-1), trades[0].OrderListId)
+}
+
+func (s *tradeServiceTestSuite) TestListTradesFromIDWithOrderID() {
+	_, err := s.client.NewListTradesService().Symbol("BNBBTC").FromID(28457).OrderID(100234).Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
+
 func (s *tradeServiceTestSuite) TestAggregateTrades() {
 	data := []byte(`[
         {
@@ -92,14 +115,12 @@ func (s *tradeServiceTestSuite) TestAggregateTrades() {
 	defer s.assertDo()
 
 	symbol := "LTCBTC"
-	fromID := int64(1)
 	startTime := int64(1498793709153)
 	endTime := int64(1498793709156)
 	limit := 1
 	s.assertReq(func(r *request) {
 		e := newRequest().setParams(params{
 			"symbol":    symbol,
-			"fromId":    fromID,
 			"startTime": startTime,
 			"endTime":   endTime,
 			"limit":     limit,
@@ -107,8 +128,9 @@ func (s *tradeServiceTestSuite) TestAggregateTrades() {
 		s.assertRequestEqual(e, r)
 	})
 
-	aggTrades, err := s.client.NewAggTradesService().Symbol(symbol).
-		FromID(fromID).StartTime(startTime).EndTime(endTime).Limit(limit).
+	var service *GetAggTradesService = s.client.NewAggTradesService()
+	aggTrades, err := service.Symbol(symbol).
+		StartTime(startTime).EndTime(endTime).Limit(limit).
 		Do(newContext())
 	r := s.r()
 	r.NoError(err)
@@ -126,6 +148,15 @@ func (s *tradeServiceTestSuite) TestAggregateTrades() {
 	s.assertAggTradeEqual(e, aggTrades[0])
 }
 
+func (s *tradeServiceTestSuite) TestAggregateTradesInvalidParams() {
+	_, err := s.client.NewAggTradesService().Symbol("LTCBTC").FromID(1).StartTime(1498793709153).Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+
+	_, err = s.client.NewAggTradesService().Symbol("LTCBTC").
+		StartTime(1498793709153).EndTime(1498793709153 + aggTradesMaxWindow).Do(newContext())
+	s.r().ErrorIs(err, ErrInvalidParam)
+}
+
 func (s *tradeServiceTestSuite) assertAggTradeEqual(e, a *AggTrade) {
 	r := s.r()
 	r.Equal(e.AggTradeID, a.AggTradeID, "AggTradeID")
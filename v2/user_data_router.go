@@ -0,0 +1,58 @@
+package binance
+
+// UserDataEventRouter dispatch the user data events to a handler per update type, register the handlers
+// then serve Handler with WsUserDataServe. The updates without a handler are dropped.
+type UserDataEventRouter struct {
+	orderUpdate         func(*WsOrderUpdate)
+	accountUpdate       func(*WsAccountUpdateList)
+	accountConfigUpdate func(*WSAccountConfigUpdate)
+	other               WsUserDataHandler
+}
+
+// OnOrderUpdate set the handler of the order updates
+func (r *UserDataEventRouter) OnOrderUpdate(handler func(*WsOrderUpdate)) *UserDataEventRouter {
+	r.orderUpdate = handler
+	return r
+}
+
+// OnAccountUpdate set the handler of the account updates
+func (r *UserDataEventRouter) OnAccountUpdate(handler func(*WsAccountUpdateList)) *UserDataEventRouter {
+	r.accountUpdate = handler
+	return r
+}
+
+// OnAccountConfigUpdate set the handler of the account config updates
+func (r *UserDataEventRouter) OnAccountConfigUpdate(handler func(*WSAccountConfigUpdate)) *UserDataEventRouter {
+	r.accountConfigUpdate = handler
+	return r
+}
+
+// OnOther set the handler of the events carrying none of the updates above, e.g. listenKeyExpired
+func (r *UserDataEventRouter) OnOther(handler WsUserDataHandler) *UserDataEventRouter {
+	r.other = handler
+	return r
+}
+
+// Handler return the handler routing each event to the handler of its update
+func (r *UserDataEventRouter) Handler() WsUserDataHandler {
+	return func(event *WsUserDataEvent) {
+		switch {
+		case event.OrderUpdate != nil:
+			if r.orderUpdate != nil {
+				r.orderUpdate(event.OrderUpdate)
+			}
+		case event.AccountUpdate != nil:
+			if r.accountUpdate != nil {
+				r.accountUpdate(event.AccountUpdate)
+			}
+		case event.AccountConfigUpdate != nil:
+			if r.accountConfigUpdate != nil {
+				r.accountConfigUpdate(event.AccountConfigUpdate)
+			}
+		default:
+			if r.other != nil {
+				r.other(event)
+			}
+		}
+	}
+}
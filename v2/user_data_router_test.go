@@ -0,0 +1,38 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserDataEventRouter(t *testing.T) {
+	r := require.New(t)
+	var orders []*WsOrderUpdate
+	var accounts []*WsAccountUpdateList
+	var configs []*WSAccountConfigUpdate
+	var others []*WsUserDataEvent
+	handler := new(UserDataEventRouter).
+		OnOrderUpdate(func(u *WsOrderUpdate) { orders = append(orders, u) }).
+		OnAccountUpdate(func(u *WsAccountUpdateList) { accounts = append(accounts, u) }).
+		OnAccountConfigUpdate(func(u *WSAccountConfigUpdate) { configs = append(configs, u) }).
+		OnOther(func(e *WsUserDataEvent) { others = append(others, e) }).
+		Handler()
+
+	order := &WsOrderUpdate{Id: 1, Symbol: "BTCUSDT"}
+	account := &WsAccountUpdateList{EventType: "ORDER"}
+	config := &WSAccountConfigUpdate{Symbol: "BTCUSDT", Leverage: 10}
+	expired := &WsUserDataEvent{Event: UserDataEventTypeListenKeyExpired}
+	handler(&WsUserDataEvent{OrderUpdate: order})
+	handler(&WsUserDataEvent{AccountUpdate: account})
+	handler(&WsUserDataEvent{AccountConfigUpdate: config})
+	handler(expired)
+
+	r.Equal([]*WsOrderUpdate{order}, orders)
+	r.Equal([]*WsAccountUpdateList{account}, accounts)
+	r.Equal([]*WSAccountConfigUpdate{config}, configs)
+	r.Equal([]*WsUserDataEvent{expired}, others)
+
+	// updates without a handler are dropped
+	new(UserDataEventRouter).Handler()(&WsUserDataEvent{OrderUpdate: order})
+}
@@ -1,7 +1,10 @@
 package binance
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,9 +21,11 @@ const (
 )
 
 var (
-	// WebsocketTimeout is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
+	// WebsocketTimeout is an interval for sending ping/pong messages if WebsocketKeepalive is enabled,
+	// the default of WithKeepaliveTimeout
 	WebsocketTimeout = time.Second * 60
-	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
+	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability,
+	// the default of WithKeepalive
 	WebsocketKeepalive = false
 )
 
@@ -42,30 +47,67 @@ func getCombinedEndpoint() string {
 
 // WsPartialDepthEvent define websocket partial depth book event
 type WsPartialDepthEvent struct {
-	Symbol       string
-	LastUpdateID int64 `json:"lastUpdateId"`
-	Bids         []Bid `json:"bids"`
-	Asks         []Ask `json:"asks"`
+	Symbol string
+	// Levels is the depth level of the stream the event belongs to: 5, 10 or 20
+	Levels       string `json:"-"`
+	LastUpdateID int64  `json:"lastUpdateId"`
+	Bids         []Bid  `json:"bids"`
+	Asks         []Ask  `json:"asks"`
 }
 
 // WsPartialDepthHandler handle websocket partial depth event
 type WsPartialDepthHandler func(event *WsPartialDepthEvent)
 
+// DepthUpdateSpeed define the update speed of a depth stream
+type DepthUpdateSpeed string
+
+// Global enums
+const (
+	DepthSpeed100Ms  DepthUpdateSpeed = "100ms"
+	DepthSpeed500Ms  DepthUpdateSpeed = "500ms"
+	DepthSpeed1000Ms DepthUpdateSpeed = "1000ms"
+)
+
+// streamSuffix return the suffix of the stream name for the update speed,
+// 1000ms being the default speed of the depth streams of the endpoint
+func (speed DepthUpdateSpeed) streamSuffix() (string, error) {
+	switch speed {
+	case DepthSpeed1000Ms:
+		return "", nil
+	case DepthSpeed100Ms, DepthSpeed500Ms:
+		return "@" + string(speed), nil
+	default:
+		return "", fmt.Errorf("%w: depth update speed %q", ErrInvalidParam, speed)
+	}
+}
+
+// WsPartialDepthServeWithSpeed serve websocket partial depth handler with a symbol, using updateSpeed updates
+func WsPartialDepthServeWithSpeed(symbol string, levels string, updateSpeed DepthUpdateSpeed, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	suffix, err := updateSpeed.streamSuffix()
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToLower(symbol), levels, suffix)
+	return wsPartialDepthServe(endpoint, symbol, levels, handler, errHandler, opts...)
+}
+
 // WsPartialDepthServe serve websocket partial depth handler with a symbol, using 1sec updates
-func WsPartialDepthServe(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth%s", getWsEndpoint(), strings.ToLower(symbol), levels)
-	return wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+//
+// Deprecated: use WsPartialDepthServeWithSpeed with DepthSpeed1000Ms
+func WsPartialDepthServe(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsPartialDepthServeWithSpeed(symbol, levels, DepthSpeed1000Ms, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe100Ms serve websocket partial depth handler with a symbol, using 100msec updates
-func WsPartialDepthServe100Ms(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth%s@100ms", getWsEndpoint(), strings.ToLower(symbol), levels)
-	return wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+//
+// Deprecated: use WsPartialDepthServeWithSpeed with DepthSpeed100Ms
+func WsPartialDepthServe100Ms(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsPartialDepthServeWithSpeed(symbol, levels, DepthSpeed100Ms, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe serve websocket partial depth handler with a symbol
-func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsPartialDepthServe(endpoint string, symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -74,6 +116,7 @@ func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthH
 		}
 		event := new(WsPartialDepthEvent)
 		event.Symbol = symbol
+		event.Levels = levels
 		event.LastUpdateID = j.Get("lastUpdateId").MustInt64()
 		bidsLen := len(j.Get("bids").MustArray())
 		event.Bids = make([]Bid, bidsLen)
@@ -98,44 +141,143 @@ func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthH
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedPartialDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
-func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
-	for s, l := range symbolLevels {
-		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
+// WsCombinedPartialDepthServe is similar to WsPartialDepthServe, but it for multiple symbols,
+// the streams are sorted by symbol
+func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsCombinedPartialDepthServeOrdered(symbolLevelList(symbolLevels), handler, errHandler, opts...)
+}
+
+// WsCombinedPartialDepthServe100Ms is similar to WsCombinedPartialDepthServe, but using 100msec updates
+func WsCombinedPartialDepthServe100Ms(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsCombinedPartialDepthServe100MsOrdered(symbolLevelList(symbolLevels), handler, errHandler, opts...)
+}
+
+// SymbolLevel define the depth level of a symbol in a combined partial depth stream
+type SymbolLevel struct {
+	Symbol string
+	Levels string
+}
+
+func symbolLevelList(symbolLevels map[string]string) []SymbolLevel {
+	list := make([]SymbolLevel, 0, len(symbolLevels))
+	for symbol, levels := range symbolLevels {
+		list = append(list, SymbolLevel{Symbol: symbol, Levels: levels})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Symbol < list[j].Symbol
+	})
+	return list
+}
+
+// WsCombinedPartialDepthServeOrdered is similar to WsCombinedPartialDepthServe, but the streams
+// keep the order of symbolLevels, duplicate streams are rejected
+func WsCombinedPartialDepthServeOrdered(symbolLevels []SymbolLevel, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return wsCombinedPartialDepthServeOrdered(symbolLevels, "", handler, errHandler, opts...)
+}
+
+// WsCombinedPartialDepthServe100MsOrdered is similar to WsCombinedPartialDepthServeOrdered, but using 100msec updates
+func WsCombinedPartialDepthServe100MsOrdered(symbolLevels []SymbolLevel, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return wsCombinedPartialDepthServeOrdered(symbolLevels, "@100ms", handler, errHandler, opts...)
+}
+
+func wsCombinedPartialDepthServeOrdered(symbolLevels []SymbolLevel, rate string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	streams := make([]string, len(symbolLevels))
+	for i, sl := range symbolLevels {
+		if err := validStreamSymbol(sl.Symbol); err != nil {
+			return nil, nil, err
+		}
+		streams[i] = fmt.Sprintf("%s@depth%s%s", strings.ToLower(sl.Symbol), sl.Levels, rate)
+	}
+	endpoint, err := combinedStreamsEndpoint(streams)
+	if err != nil {
+		return nil, nil, err
+	}
+	return wsCombinedPartialDepthServe(endpoint, handler, errHandler, opts...)
+}
+
+// WebsocketMaxCombinedStreams is the maximum number of streams of a combined stream
+var WebsocketMaxCombinedStreams = 1024
+
+// ErrNoStreams is returned by the combined serve functions when there is no stream to subscribe
+var ErrNoStreams = errors.New("no stream")
+
+// validStreamSymbol check that symbol can be used in a stream name
+func validStreamSymbol(symbol string) error {
+	if symbol == "" || strings.ContainsAny(symbol, "/@") {
+		return fmt.Errorf("invalid stream symbol %q", symbol)
+	}
+	return nil
+}
+
+// combinedSymbolsEndpoint return the endpoint of a combined stream with a stream of format for every symbol
+func combinedSymbolsEndpoint(symbols []string, format string) (string, error) {
+	streams := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		if err := validStreamSymbol(symbol); err != nil {
+			return "", err
+		}
+		streams[i] = fmt.Sprintf(format, strings.ToLower(symbol))
+	}
+	return combinedStreamsEndpoint(streams)
+}
+
+// combinedStreamsEndpoint return the endpoint of a combined stream,
+// an error when the streams are empty, duplicated or too many
+func combinedStreamsEndpoint(streams []string) (string, error) {
+	if len(streams) == 0 {
+		return "", ErrNoStreams
+	}
+	if len(streams) > WebsocketMaxCombinedStreams {
+		return "", fmt.Errorf("%d streams exceed the maximum of %d per connection", len(streams), WebsocketMaxCombinedStreams)
+	}
+	seen := make(map[string]bool, len(streams))
+	for _, stream := range streams {
+		if seen[stream] {
+			return "", fmt.Errorf("duplicate stream %s", stream)
+		}
+		seen[stream] = true
 	}
-	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	return getCombinedEndpoint() + strings.Join(streams, "/"), nil
+}
+
+// wsCombinedEvent define the envelope of the events of a combined stream
+type wsCombinedEvent struct {
+	Stream string             `json:"stream"`
+	Data   stdjson.RawMessage `json:"data"`
+}
+
+// wsPartialDepthData define the payload of a partial depth event
+type wsPartialDepthData struct {
+	LastUpdateID int64 `json:"lastUpdateId"`
+	Bids         []Bid `json:"bids"`
+	Asks         []Ask `json:"asks"`
+}
+
+func wsCombinedPartialDepthServe(endpoint string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
+		combined := new(wsCombinedEvent)
+		err := json.Unmarshal(message, combined)
 		if err != nil {
 			errHandler(err)
 			return
 		}
-		event := new(WsPartialDepthEvent)
-		stream := j.Get("stream").MustString()
-		symbol := strings.Split(stream, "@")[0]
-		event.Symbol = strings.ToUpper(symbol)
-		data := j.Get("data").MustMap()
-		event.LastUpdateID, _ = data["lastUpdateId"].(stdjson.Number).Int64()
-		bidsLen := len(data["bids"].([]interface{}))
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := data["bids"].([]interface{})[i].([]interface{})
-			event.Bids[i] = Bid{
-				Price:    item[0].(string),
-				Quantity: item[1].(string),
-			}
+		data := new(wsPartialDepthData)
+		err = json.Unmarshal(combined.Data, data)
+		if err != nil {
+			errHandler(err)
+			return
 		}
-		asksLen := len(data["asks"].([]interface{}))
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-
-			item := data["asks"].([]interface{})[i].([]interface{})
-			event.Asks[i] = Ask{
-				Price:    item[0].(string),
-				Quantity: item[1].(string),
-			}
+		// the stream is <symbol>@depth<levels>[@100ms]
+		parts := strings.Split(combined.Stream, "@")
+		event := &WsPartialDepthEvent{
+			Symbol:       strings.ToUpper(parts[0]),
+			LastUpdateID: data.LastUpdateID,
+			Bids:         data.Bids,
+			Asks:         data.Asks,
+		}
+		if len(parts) > 1 {
+			event.Levels = strings.TrimPrefix(parts[1], "depth")
 		}
 		handler(event)
 	}
@@ -145,21 +287,33 @@ func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsParti
 // WsDepthHandler handle websocket depth event
 type WsDepthHandler func(event *WsDepthEvent)
 
+// WsDepthServeWithSpeed serve websocket depth handler with a symbol, using speed updates
+func WsDepthServeWithSpeed(symbol string, speed DepthUpdateSpeed, handler WsDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	suffix, err := speed.streamSuffix()
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("%s/%s@depth%s", getWsEndpoint(), strings.ToLower(symbol), suffix)
+	return wsDepthServe(endpoint, handler, errHandler, opts...)
+}
+
 // WsDepthServe serve websocket depth handler with a symbol, using 1sec updates
-func WsDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth", getWsEndpoint(), strings.ToLower(symbol))
-	return wsDepthServe(endpoint, handler, errHandler)
+//
+// Deprecated: use WsDepthServeWithSpeed with DepthSpeed1000Ms
+func WsDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsDepthServeWithSpeed(symbol, DepthSpeed1000Ms, handler, errHandler, opts...)
 }
 
 // WsDepthServe100Ms serve websocket depth handler with a symbol, using 100msec updates
-func WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth@100ms", getWsEndpoint(), strings.ToLower(symbol))
-	return wsDepthServe(endpoint, handler, errHandler)
+//
+// Deprecated: use WsDepthServeWithSpeed with DepthSpeed100Ms
+func WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsDepthServeWithSpeed(symbol, DepthSpeed100Ms, handler, errHandler, opts...)
 }
 
 // WsDepthServe serve websocket depth handler with an arbitrary endpoint address
-func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -212,27 +366,35 @@ type WsDepthEvent struct {
 	Asks                     []Ask  `json:"a"`
 }
 
-// WsCombinedDepthServe is similar to WsDepthServe, but it for multiple symbols
-func WsCombinedDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
-	for _, s := range symbols {
-		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
+// WsCombinedDepthServeWithSpeed is similar to WsDepthServeWithSpeed, but it for multiple symbols
+func WsCombinedDepthServeWithSpeed(symbols []string, speed DepthUpdateSpeed, handler WsDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	suffix, err := speed.streamSuffix()
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint, err := combinedSymbolsEndpoint(symbols, "%s@depth"+suffix)
+	if err != nil {
+		return nil, nil, err
 	}
-	endpoint = endpoint[:len(endpoint)-1]
-	return wsCombinedDepthServe(endpoint, handler, errHandler)
+	return wsCombinedDepthServe(endpoint, handler, errHandler, opts...)
 }
 
-func WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
-	for _, s := range symbols {
-		endpoint += fmt.Sprintf("%s@depth@100ms", strings.ToLower(s)) + "/"
-	}
-	endpoint = endpoint[:len(endpoint)-1]
-	return wsCombinedDepthServe(endpoint, handler, errHandler)
+// WsCombinedDepthServe is similar to WsDepthServe, but it for multiple symbols
+//
+// Deprecated: use WsCombinedDepthServeWithSpeed with DepthSpeed1000Ms
+func WsCombinedDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsCombinedDepthServeWithSpeed(symbols, DepthSpeed1000Ms, handler, errHandler, opts...)
+}
+
+// WsCombinedDepthServe100Ms is similar to WsDepthServe100Ms, but it for multiple symbols
+//
+// Deprecated: use WsCombinedDepthServeWithSpeed with DepthSpeed100Ms
+func WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsCombinedDepthServeWithSpeed(symbols, DepthSpeed100Ms, handler, errHandler, opts...)
 }
 
-func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -280,14 +442,40 @@ func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler Er
 // WsKlineHandler handle websocket kline event
 type WsKlineHandler func(event *WsKlineEvent)
 
-// WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
-func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+// WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval,
+// the streams are sorted by symbol
+func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	list := make([]SymbolInterval, 0, len(symbolIntervalPair))
 	for symbol, interval := range symbolIntervalPair {
-		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
+		list = append(list, SymbolInterval{Symbol: symbol, Interval: interval})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Symbol < list[j].Symbol
+	})
+	return WsCombinedKlineServeOrdered(list, handler, errHandler, opts...)
+}
+
+// SymbolInterval define the kline interval of a symbol in a combined kline stream
+type SymbolInterval struct {
+	Symbol   string
+	Interval string
+}
+
+// WsCombinedKlineServeOrdered is similar to WsCombinedKlineServe, but the streams keep the order of
+// symbolIntervals, a symbol can have several intervals and duplicate streams are rejected
+func WsCombinedKlineServeOrdered(symbolIntervals []SymbolInterval, handler WsKlineHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	streams := make([]string, len(symbolIntervals))
+	for i, si := range symbolIntervals {
+		if err := validStreamSymbol(si.Symbol); err != nil {
+			return nil, nil, err
+		}
+		streams[i] = fmt.Sprintf("%s@kline_%s", strings.ToLower(si.Symbol), si.Interval)
+	}
+	endpoint, err := combinedStreamsEndpoint(streams)
+	if err != nil {
+		return nil, nil, err
 	}
-	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -316,9 +504,9 @@ func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineH
 }
 
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -363,9 +551,19 @@ type WsKline struct {
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
 // WsAggTradeServe serve websocket aggregate handler with a symbol
-func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return wsAggTradeServe(endpoint, handler, errHandler, opts...)
+}
+
+// WsAggTradeServe100Ms serve websocket aggregate handler with a symbol, using 100msec updates
+func WsAggTradeServe100Ms(symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@aggTrade@100ms", getWsEndpoint(), strings.ToLower(symbol))
+	return wsAggTradeServe(endpoint, handler, errHandler, opts...)
+}
+
+func wsAggTradeServe(endpoint string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -379,13 +577,48 @@ func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHan
 }
 
 // WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbolx
-func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
-	for s := range symbols {
-		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(symbols[s])) + "/"
+func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint, err := combinedSymbolsEndpoint(symbols, "%s@aggTrade")
+	if err != nil {
+		return nil, nil, err
 	}
-	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	return wsCombinedAggTradeServe(endpoint, handler, errHandler, opts...)
+}
+
+// WsCombinedAggTradeServe100Ms is similar to WsCombinedAggTradeServe, but using 100msec updates
+func WsCombinedAggTradeServe100Ms(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint, err := combinedSymbolsEndpoint(symbols, "%s@aggTrade@100ms")
+	if err != nil {
+		return nil, nil, err
+	}
+	return wsCombinedAggTradeServe(endpoint, handler, errHandler, opts...)
+}
+
+// WsCombinedAggTradeServeDispatch is similar to WsCombinedAggTradeServe, but each event is
+// dispatched to the handler of its symbol, so that every symbol can be consumed independently
+func WsCombinedAggTradeServeDispatch(handlers map[string]WsAggTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	dispatch := make(map[string]WsAggTradeHandler, len(handlers))
+	symbols := make([]string, 0, len(handlers))
+	for symbol, handler := range handlers {
+		dispatch[strings.ToUpper(symbol)] = handler
+		symbols = append(symbols, symbol)
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		return strings.ToLower(symbols[i]) < strings.ToLower(symbols[j])
+	})
+	endpoint, err := combinedSymbolsEndpoint(symbols, "%s@aggTrade")
+	if err != nil {
+		return nil, nil, err
+	}
+	return wsCombinedAggTradeServe(endpoint, func(event *WsAggTradeEvent) {
+		if handler, ok := dispatch[event.Symbol]; ok {
+			handler(event)
+		}
+	}, errHandler, opts...)
+}
+
+func wsCombinedAggTradeServe(endpoint string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -431,9 +664,9 @@ type WsAssetIndexEvent struct {
 
 type WsAssetIndexHandler func(event []WsAssetIndexEvent)
 
-func WsAssetIndexServer(handler WsAssetIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAssetIndexServer(handler WsAssetIndexHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!assetIndex@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := []WsAssetIndexEvent{}
 		err := json.Unmarshal(message, &event)
@@ -466,9 +699,9 @@ type WsTradeHandler func(event *WsTradeEvent)
 type WsCombinedTradeHandler func(event *WsCombinedTradeEvent)
 
 // WsTradeServe serve websocket handler with a symbol
-func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@trade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -481,13 +714,12 @@ func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-func WsCombinedTradeServe(symbols []string, handler WsCombinedTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
-	for _, s := range symbols {
-		endpoint += fmt.Sprintf("%s@trade/", strings.ToLower(s))
+func WsCombinedTradeServe(symbols []string, handler WsCombinedTradeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint, err := combinedSymbolsEndpoint(symbols, "%s@trade")
+	if err != nil {
+		return nil, nil, err
 	}
-	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -529,6 +761,9 @@ type WsUserDataEvent struct {
 	OrderUpdate         *WsOrderUpdate         `json:"o"`
 	AccountUpdate       *WsAccountUpdateList   `json:"a"`
 	AccountConfigUpdate *WSAccountConfigUpdate `json:"ac"`
+	// RawMessage is the message the event was decoded from, to read the fields of event types
+	// missing from WsUserDataEvent
+	RawMessage stdjson.RawMessage `json:"-"`
 }
 
 type WSAccountConfigUpdate struct {
@@ -616,19 +851,176 @@ type WsOCOOrder struct {
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
+// knownUserDataEventTypes is the user data event types known by this package
+var knownUserDataEventTypes = map[UserDataEventType]bool{
+	UserDataEventTypeOutboundAccountPosition: true,
+	UserDataEventTypeBalanceUpdate:           true,
+	UserDataEventTypeExecutionReport:         true,
+	UserDataEventTypeListStatus:              true,
+	UserDataEventTypeListenKeyExpired:        true,
+	UserDataEventTypeEventStreamTerminated:   true,
+}
+
 // WsUserDataServe serve user data handler with listen key
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	return WsUserDataServeWithUnknownHandler(listenKey, handler, nil, errHandler, opts...)
+}
+
+// WsUserDataServeWithUnknownHandler is similar to WsUserDataServe, but the events of a type
+// unknown by this package are sent to unknownHandler instead of handler, when it is not nil.
+// The events sent to unknownHandler are not decoded, only their Event, Time and RawMessage fields are set,
+// as their fields can clash with the ones of the known events.
+// The original message of an event is kept in its RawMessage field.
+func WsUserDataServeWithUnknownHandler(listenKey string, handler WsUserDataHandler, unknownHandler WsUserDataHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
-
+		if unknownHandler != nil {
+			header := new(struct {
+				Event UserDataEventType `json:"e"`
+				Time  int64             `json:"E"`
+			})
+			if err := json.Unmarshal(message, header); err != nil {
+				errHandler(err)
+				return
+			}
+			if !knownUserDataEventTypes[header.Event] {
+				unknownHandler(&WsUserDataEvent{
+					Event:      header.Event,
+					Time:       header.Time,
+					RawMessage: append(stdjson.RawMessage(nil), message...),
+				})
+				return
+			}
+		}
 		event := new(WsUserDataEvent)
-		err = json.Unmarshal(message, event)
+		err := json.Unmarshal(message, event)
 		if err != nil {
 			errHandler(err)
 			return
 		}
+		event.RawMessage = append(stdjson.RawMessage(nil), message...)
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsSpotUserDataEvent define a spot user data event, only the field of the event type is set
+type WsSpotUserDataEvent struct {
+	Event           UserDataEventType
+	Time            int64
+	AccountPosition *WsSpotAccountPosition
+	BalanceUpdate   *WsSpotBalanceUpdate
+	ExecutionReport *WsSpotExecutionReport
+}
+
+// WsSpotAccountPosition define the balances of the assets changed by an outboundAccountPosition event
+type WsSpotAccountPosition struct {
+	LastUpdateTime int64           `json:"u"`
+	Balances       []WsSpotBalance `json:"B"`
+}
+
+// WsSpotBalance define the balance of an asset
+type WsSpotBalance struct {
+	Asset  string `json:"a"`
+	Free   string `json:"f"`
+	Locked string `json:"l"`
+}
+
+// WsSpotBalanceUpdate define a deposit, withdrawal or transfer of a balanceUpdate event
+type WsSpotBalanceUpdate struct {
+	Asset     string `json:"a"`
+	Delta     string `json:"d"`
+	ClearTime int64  `json:"T"`
+}
+
+// WsSpotExecutionReport define an order update of an executionReport event
+type WsSpotExecutionReport struct {
+	Symbol                   string          `json:"s"`
+	ClientOrderID            string          `json:"c"`
+	Side                     SideType        `json:"S"`
+	Type                     OrderType       `json:"o"`
+	TimeInForce              TimeInForceType `json:"f"`
+	Quantity                 string          `json:"q"`
+	Price                    string          `json:"p"`
+	StopPrice                string          `json:"P"`
+	IcebergQuantity          string          `json:"F"`
+	OrderListID              int64           `json:"g"`
+	OrigClientOrderID        string          `json:"C"` // the id of the canceled order
+	ExecutionType            string          `json:"x"`
+	Status                   OrderStatusType `json:"X"`
+	RejectReason             string          `json:"r"`
+	OrderID                  int64           `json:"i"`
+	LastExecutedQuantity     string          `json:"l"`
+	CumulativeFilledQuantity string          `json:"z"`
+	LastExecutedPrice        string          `json:"L"`
+	Commission               string          `json:"n"`
+	CommissionAsset          string          `json:"N"`
+	TransactionTime          int64           `json:"T"`
+	TradeID                  int64           `json:"t"`
+	IsWorking                bool            `json:"w"`
+	IsMaker                  bool            `json:"m"`
+	CreationTime             int64           `json:"O"`
+	CumulativeQuoteQuantity  string          `json:"Z"`
+	LastQuoteQuantity        string          `json:"Y"`
+	QuoteOrderQuantity       string          `json:"Q"`
+	WorkingTime              int64           `json:"W"`
+	SelfTradePreventionMode  STPMode         `json:"V"`
+	PreventedMatchID         int64           `json:"v"`
+}
+
+// UnmarshalJSON decode the report, skipping its ignored I and M fields
+func (r *WsSpotExecutionReport) UnmarshalJSON(data []byte) error {
+	type report WsSpotExecutionReport
+	v := struct {
+		*report
+		// declared to avoid the case insensitive unmarshaling of I and M into i and m
+		Ignore1 int64 `json:"I"`
+		Ignore2 bool  `json:"M"`
+	}{report: (*report)(r)}
+	return json.Unmarshal(data, &v)
+}
+
+// WsSpotUserDataHandler handle WsSpotUserDataEvent
+type WsSpotUserDataHandler func(event *WsSpotUserDataEvent)
 
+// WsSpotUserDataServe serve the spot user data events of the listen key: outboundAccountPosition,
+// balanceUpdate and executionReport events are passed to handler, the messages of the other event types
+// are passed to rawHandler, or dropped when it is nil
+func WsSpotUserDataServe(listenKey string, handler WsSpotUserDataHandler, rawHandler WsHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := func(message []byte) {
+		header := struct {
+			Event UserDataEventType `json:"e"`
+			Time  int64             `json:"E"`
+		}{}
+		err := json.Unmarshal(message, &header)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		event := &WsSpotUserDataEvent{Event: header.Event, Time: header.Time}
+		switch header.Event {
+		case UserDataEventTypeOutboundAccountPosition:
+			event.AccountPosition = new(WsSpotAccountPosition)
+			err = json.Unmarshal(message, event.AccountPosition)
+		case UserDataEventTypeBalanceUpdate:
+			event.BalanceUpdate = new(WsSpotBalanceUpdate)
+			err = json.Unmarshal(message, event.BalanceUpdate)
+		case UserDataEventTypeExecutionReport:
+			event.ExecutionReport = new(WsSpotExecutionReport)
+			err = json.Unmarshal(message, event.ExecutionReport)
+		default:
+			if rawHandler != nil {
+				rawHandler(message)
+			}
+			return
+		}
+		if err != nil {
+			errHandler(err)
+			return
+		}
 		handler(event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
@@ -638,13 +1030,12 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 type WsMarketStatHandler func(event *WsMarketStatEvent)
 
 // WsCombinedMarketStatServe is similar to WsMarketStatServe, but it handles multiple symbolx
-func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
-	for s := range symbols {
-		endpoint += fmt.Sprintf("%s@ticker", strings.ToLower(symbols[s])) + "/"
+func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint, err := combinedSymbolsEndpoint(symbols, "%s@ticker")
+	if err != nil {
+		return nil, nil, err
 	}
-	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
@@ -675,9 +1066,9 @@ func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, er
 }
 
 // WsMarketStatServe serve websocket that push 24hr statistics for single market every second
-func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsMarketStatEvent
 		err := json.Unmarshal(message, &event)
@@ -694,9 +1085,9 @@ func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler Er
 type WsAllMarketsStatHandler func(event WsAllMarketsStatEvent)
 
 // WsAllMarketsStatServe serve websocket that push 24hr statistics for all market every second
-func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -737,15 +1128,71 @@ type WsMarketStatEvent struct {
 	FirstID            int64  `json:"F"`
 	LastID             int64  `json:"L"`
 	Count              int64  `json:"n"`
+
+	priceChange        parsedFloat
+	priceChangePercent parsedFloat
+}
+
+// parsedFloat hold a decimal string field parsed when the event is decoded
+type parsedFloat struct {
+	src   string
+	value float64
+	err   error
+}
+
+func newParsedFloat(s string) parsedFloat {
+	f := parsedFloat{src: s}
+	f.value, f.err = strconv.ParseFloat(s, 64)
+	return f
+}
+
+// get return the parsed value of s, s is only parsed again if it is not the decoded string
+func (f parsedFloat) get(s string) (float64, error) {
+	if f.src == s && (s != "" || f.err != nil) {
+		return f.value, f.err
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// UnmarshalJSON decode the event and parse its float fields once,
+// the Float64 methods only read the parsed values so they are safe for concurrent use
+func (e *WsMarketStatEvent) UnmarshalJSON(data []byte) error {
+	type event WsMarketStatEvent
+	if err := json.Unmarshal(data, (*event)(e)); err != nil {
+		return err
+	}
+	e.priceChange = newParsedFloat(e.PriceChange)
+	e.priceChangePercent = newParsedFloat(e.PriceChangePercent)
+	return nil
+}
+
+// PriceChangeFloat64 return PriceChange as a float64, it is parsed when the event is decoded
+func (e *WsMarketStatEvent) PriceChangeFloat64() (float64, error) {
+	return e.priceChange.get(e.PriceChange)
+}
+
+// PriceChangePercentFloat64 return PriceChangePercent as a float64, it is parsed when the event is decoded
+func (e *WsMarketStatEvent) PriceChangePercentFloat64() (float64, error) {
+	return e.priceChangePercent.get(e.PriceChangePercent)
 }
 
 // WsAllMiniMarketsStatServeHandler handle websocket that push all mini-ticker market statistics for 24hr
 type WsAllMiniMarketsStatServeHandler func(event WsAllMiniMarketsStatEvent)
 
 // WsAllMiniMarketsStatServe serve websocket that push mini version of 24hr statistics for all market every second
-func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return wsAllMiniMarketsStatServe(endpoint, handler, errHandler, opts...)
+}
+
+// WsAllMiniMarketsStatServe100Ms serve websocket that push mini version of 24hr statistics for all market every 100msec
+func WsAllMiniMarketsStatServe100Ms(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!miniTicker@arr@100ms", getWsEndpoint())
+	return wsAllMiniMarketsStatServe(endpoint, handler, errHandler, opts...)
+}
+
+func wsAllMiniMarketsStatServe(endpoint string, handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -784,6 +1231,7 @@ type WsBookTickerEvent struct {
 	BestAskQty   string `json:"A"`
 }
 
+// WsCombinedBookTickerEvent define websocket best book ticker event of a combined stream
 type WsCombinedBookTickerEvent struct {
 	Data   *WsBookTickerEvent `json:"data"`
 	Stream string             `json:"stream"`
@@ -792,10 +1240,13 @@ type WsCombinedBookTickerEvent struct {
 // WsBookTickerHandler handle websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
+// WsCombinedBookTickerHandler handle websocket best book ticker event of a combined stream, with the stream name
+type WsCombinedBookTickerHandler func(event *WsCombinedBookTickerEvent)
+
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
-func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -809,13 +1260,12 @@ func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler Er
 }
 
 // WsCombinedBookTickerServe is similar to WsBookTickerServe, but it is for multiple symbols
-func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := baseCombinedMainURL
-	for _, s := range symbols {
-		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(s)) + "/"
+func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint, err := combinedSymbolsEndpoint(symbols, "%s@bookTicker")
+	if err != nil {
+		return nil, nil, err
 	}
-	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedBookTickerEvent)
 		err := json.Unmarshal(message, event)
@@ -828,10 +1278,29 @@ func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsCombinedBookTickerServeWithStream is similar to WsCombinedBookTickerServe, but the handler receives the stream name with the event
+func WsCombinedBookTickerServeWithStream(symbols []string, handler WsCombinedBookTickerHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint, err := combinedSymbolsEndpoint(symbols, "%s@bookTicker")
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := func(message []byte) {
+		event := new(WsCombinedBookTickerEvent)
+		err := json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
-func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -868,9 +1337,9 @@ type WsCombinedMarkPriceForAllEvent struct {
 type WsMarkPriceForAllHandler func(event *WsMarkPriceForAllEvent)
 
 // WsCombinedMarkPriceForAllServe websocket that pushes mark price multiple symbol.
-func WsCombinedMarkPriceForAllServe(handler WsMarkPriceForAllHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedMarkPriceForAllServe(handler WsMarkPriceForAllHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s!markPrice@arr", getCombinedEndpoint())
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -892,3 +1361,38 @@ func WsCombinedMarkPriceForAllServe(handler WsMarkPriceForAllHandler, errHandler
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
+
+// WsRawServe serve websocket handler with the raw messages of streamPath, so that a stream
+// which is not wrapped by this package yet can be consumed, e.g. "btcusdt@avgPrice"
+func WsRawServe(streamPath string, handler WsHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	streamPath = strings.TrimPrefix(streamPath, "/")
+	if streamPath == "" {
+		return nil, nil, ErrNoStreams
+	}
+	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), streamPath)
+	cfg := newWsConfig(endpoint, opts...)
+	return wsServe(cfg, handler, errHandler)
+}
+
+// WsRawCombinedHandler handle the raw data of an event of a combined stream, with the stream name
+type WsRawCombinedHandler func(stream string, data []byte)
+
+// WsRawCombinedServe is similar to WsRawServe, but it for multiple streams, the handler receives
+// the payload of the combined stream envelope with the name of its stream
+func WsRawCombinedServe(streams []string, handler WsRawCombinedHandler, errHandler ErrHandler, opts ...WsConfigOption) (doneC, stopC chan struct{}, err error) {
+	endpoint, err := combinedStreamsEndpoint(streams)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := func(message []byte) {
+		event := new(wsCombinedEvent)
+		err := stdjson.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event.Stream, event.Data)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
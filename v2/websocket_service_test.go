@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
 )
 
 type websocketServiceTestSuite struct {
@@ -151,6 +153,61 @@ func (s *websocketServiceTestSuite) TestPartialDepthServe100Ms() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestPartialDepthServeWithSpeed() {
+	var endpoints []string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	r := s.r()
+	noop := func(err error) {}
+
+	for _, speed := range []DepthUpdateSpeed{DepthSpeed1000Ms, DepthSpeed500Ms, DepthSpeed100Ms} {
+		_, _, err := WsPartialDepthServeWithSpeed("ETHBTC", "10", speed, func(*WsPartialDepthEvent) {}, noop)
+		r.NoError(err)
+	}
+	r.Equal([]string{
+		getWsEndpoint() + "/ethbtc@depth10",
+		getWsEndpoint() + "/ethbtc@depth10@500ms",
+		getWsEndpoint() + "/ethbtc@depth10@100ms",
+	}, endpoints)
+
+	_, _, err := WsPartialDepthServeWithSpeed("ETHBTC", "10", DepthUpdateSpeed("250ms"), func(*WsPartialDepthEvent) {}, noop)
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Len(endpoints, 3)
+}
+
+func (s *websocketServiceTestSuite) TestDepthServeWithSpeed() {
+	var endpoints []string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	r := s.r()
+	noop := func(err error) {}
+
+	for _, speed := range []DepthUpdateSpeed{DepthSpeed1000Ms, DepthSpeed500Ms, DepthSpeed100Ms} {
+		_, _, err := WsDepthServeWithSpeed("ETHBTC", speed, func(*WsDepthEvent) {}, noop)
+		r.NoError(err)
+		_, _, err = WsCombinedDepthServeWithSpeed([]string{"ETHBTC", "BNBBTC"}, speed, func(*WsDepthEvent) {}, noop)
+		r.NoError(err)
+	}
+	r.Equal([]string{
+		getWsEndpoint() + "/ethbtc@depth",
+		getCombinedEndpoint() + "ethbtc@depth/bnbbtc@depth",
+		getWsEndpoint() + "/ethbtc@depth@500ms",
+		getCombinedEndpoint() + "ethbtc@depth@500ms/bnbbtc@depth@500ms",
+		getWsEndpoint() + "/ethbtc@depth@100ms",
+		getCombinedEndpoint() + "ethbtc@depth@100ms/bnbbtc@depth@100ms",
+	}, endpoints)
+
+	_, _, err := WsDepthServeWithSpeed("ETHBTC", DepthUpdateSpeed("250ms"), func(*WsDepthEvent) {}, noop)
+	r.ErrorIs(err, ErrInvalidParam)
+	_, _, err = WsCombinedDepthServeWithSpeed([]string{"ETHBTC"}, DepthUpdateSpeed("250ms"), func(*WsDepthEvent) {}, noop)
+	r.ErrorIs(err, ErrInvalidParam)
+	r.Len(endpoints, 6)
+}
+
 func (s *websocketServiceTestSuite) TestCombinedPartialDepthServe() {
 	data := []byte(`{
       "stream":"ethusdt@depth5",
@@ -206,6 +263,193 @@ func (s *websocketServiceTestSuite) TestCombinedPartialDepthServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestCombinedPartialDepthServe100MsMixedLevels() {
+	messages := [][]byte{
+		[]byte(`{"stream":"btcusdt@depth10@100ms","data":{"lastUpdateId":160,"bids":[["0.0024","10",[]]],"asks":[]}}`),
+		[]byte(`{"stream":"ethusdt@depth5@100ms","data":{"lastUpdateId":161,"bids":[],"asks":[["0.0026","100"]]}}`),
+		[]byte(`{"stream":"ethusdt@depth5@100ms","data":{"lastUpdateId":162,"bids":[[0.0024,"10"]],"asks":[]}}`),
+	}
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		for _, message := range messages {
+			handler(message)
+		}
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+
+	var events []*WsPartialDepthEvent
+	var errs []error
+	doneC, stopC, err := WsCombinedPartialDepthServe100Ms(map[string]string{"BTCUSDT": "10", "ETHUSDT": "5"}, func(event *WsPartialDepthEvent) {
+		events = append(events, event)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	r := s.r()
+	r.NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	r.Contains(endpoint, "btcusdt@depth10@100ms")
+	r.Contains(endpoint, "ethusdt@depth5@100ms")
+	r.Equal([]*WsPartialDepthEvent{
+		{Symbol: "BTCUSDT", Levels: "10", LastUpdateID: 160, Bids: []Bid{{Price: "0.0024", Quantity: "10"}}, Asks: []Ask{}},
+		{Symbol: "ETHUSDT", Levels: "5", LastUpdateID: 161, Bids: []Bid{}, Asks: []Ask{{Price: "0.0026", Quantity: "100"}}},
+	}, events)
+	// a malformed level is reported instead of panicking
+	r.Len(errs, 1)
+	r.ErrorContains(errs[0], "invalid price level price")
+}
+
+func (s *websocketServiceTestSuite) TestCombinedServeStreamOrder() {
+	var endpoints []string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	r := s.r()
+	noop := func(err error) {}
+
+	_, _, err := WsCombinedPartialDepthServeOrdered([]SymbolLevel{{"ETHUSDT", "5"}, {"BTCUSDT", "10"}}, func(*WsPartialDepthEvent) {}, noop)
+	r.NoError(err)
+	_, _, err = WsCombinedPartialDepthServe(map[string]string{"ETHUSDT": "5", "BTCUSDT": "10"}, func(*WsPartialDepthEvent) {}, noop)
+	r.NoError(err)
+	_, _, err = WsCombinedKlineServeOrdered([]SymbolInterval{{"BTCUSDT", "1m"}, {"BTCUSDT", "1h"}}, func(*WsKlineEvent) {}, noop)
+	r.NoError(err)
+	_, _, err = WsCombinedKlineServe(map[string]string{"ETHUSDT": "1m", "BTCUSDT": "1h"}, func(*WsKlineEvent) {}, noop)
+	r.NoError(err)
+	r.Equal([]string{
+		getCombinedEndpoint() + "ethusdt@depth5/btcusdt@depth10",
+		getCombinedEndpoint() + "btcusdt@depth10/ethusdt@depth5",
+		getCombinedEndpoint() + "btcusdt@kline_1m/btcusdt@kline_1h",
+		getCombinedEndpoint() + "btcusdt@kline_1h/ethusdt@kline_1m",
+	}, endpoints)
+}
+
+func (s *websocketServiceTestSuite) TestCombinedServeStreamErrors() {
+	s.mockWsServe(nil, nil)
+	defer s.assertWsServe(0)
+	r := s.r()
+	noop := func(err error) {}
+
+	_, _, err := WsCombinedKlineServeOrdered([]SymbolInterval{{"BTCUSDT", "1m"}, {"btcusdt", "1m"}}, func(*WsKlineEvent) {}, noop)
+	r.EqualError(err, "duplicate stream btcusdt@kline_1m")
+	_, _, err = WsCombinedPartialDepthServe(map[string]string{"BTCUSDT": "5", "btcusdt": "5"}, func(*WsPartialDepthEvent) {}, noop)
+	r.EqualError(err, "duplicate stream btcusdt@depth5")
+	_, _, err = WsCombinedKlineServe(nil, func(*WsKlineEvent) {}, noop)
+	r.EqualError(err, "no stream")
+
+	defer func(max int) {
+		WebsocketMaxCombinedStreams = max
+	}(WebsocketMaxCombinedStreams)
+	WebsocketMaxCombinedStreams = 1
+	_, _, err = WsCombinedPartialDepthServe100MsOrdered([]SymbolLevel{{"BTCUSDT", "5"}, {"ETHUSDT", "5"}}, func(*WsPartialDepthEvent) {}, noop)
+	r.EqualError(err, "2 streams exceed the maximum of 1 per connection")
+}
+
+func (s *websocketServiceTestSuite) TestCombinedServeInvalidStreams() {
+	s.mockWsServe(nil, nil)
+	defer s.assertWsServe(0)
+	noop := func(err error) {}
+	levels := func(symbols []string) map[string]string {
+		m := make(map[string]string, len(symbols))
+		for _, symbol := range symbols {
+			m[symbol] = "5"
+		}
+		return m
+	}
+	serves := map[string]func(symbols []string) error{
+		"PartialDepth": func(symbols []string) error {
+			_, _, err := WsCombinedPartialDepthServe(levels(symbols), func(*WsPartialDepthEvent) {}, noop)
+			return err
+		},
+		"PartialDepth100Ms": func(symbols []string) error {
+			_, _, err := WsCombinedPartialDepthServe100Ms(levels(symbols), func(*WsPartialDepthEvent) {}, noop)
+			return err
+		},
+		"PartialDepthOrdered": func(symbols []string) error {
+			list := make([]SymbolLevel, len(symbols))
+			for i, symbol := range symbols {
+				list[i] = SymbolLevel{Symbol: symbol, Levels: "5"}
+			}
+			_, _, err := WsCombinedPartialDepthServeOrdered(list, func(*WsPartialDepthEvent) {}, noop)
+			return err
+		},
+		"Depth": func(symbols []string) error {
+			_, _, err := WsCombinedDepthServe(symbols, func(*WsDepthEvent) {}, noop)
+			return err
+		},
+		"Depth100Ms": func(symbols []string) error {
+			_, _, err := WsCombinedDepthServe100Ms(symbols, func(*WsDepthEvent) {}, noop)
+			return err
+		},
+		"Kline": func(symbols []string) error {
+			intervals := make(map[string]string, len(symbols))
+			for _, symbol := range symbols {
+				intervals[symbol] = "1m"
+			}
+			_, _, err := WsCombinedKlineServe(intervals, func(*WsKlineEvent) {}, noop)
+			return err
+		},
+		"KlineOrdered": func(symbols []string) error {
+			list := make([]SymbolInterval, len(symbols))
+			for i, symbol := range symbols {
+				list[i] = SymbolInterval{Symbol: symbol, Interval: "1m"}
+			}
+			_, _, err := WsCombinedKlineServeOrdered(list, func(*WsKlineEvent) {}, noop)
+			return err
+		},
+		"AggTrade": func(symbols []string) error {
+			_, _, err := WsCombinedAggTradeServe(symbols, func(*WsAggTradeEvent) {}, noop)
+			return err
+		},
+		"AggTrade100Ms": func(symbols []string) error {
+			_, _, err := WsCombinedAggTradeServe100Ms(symbols, func(*WsAggTradeEvent) {}, noop)
+			return err
+		},
+		"AggTradeDispatch": func(symbols []string) error {
+			handlers := make(map[string]WsAggTradeHandler, len(symbols))
+			for _, symbol := range symbols {
+				handlers[symbol] = func(*WsAggTradeEvent) {}
+			}
+			_, _, err := WsCombinedAggTradeServeDispatch(handlers, noop)
+			return err
+		},
+		"Trade": func(symbols []string) error {
+			_, _, err := WsCombinedTradeServe(symbols, func(*WsCombinedTradeEvent) {}, noop)
+			return err
+		},
+		"MarketStat": func(symbols []string) error {
+			_, _, err := WsCombinedMarketStatServe(symbols, func(*WsMarketStatEvent) {}, noop)
+			return err
+		},
+		"BookTicker": func(symbols []string) error {
+			_, _, err := WsCombinedBookTickerServe(symbols, func(*WsBookTickerEvent) {}, noop)
+			return err
+		},
+		"BookTickerWithStream": func(symbols []string) error {
+			_, _, err := WsCombinedBookTickerServeWithStream(symbols, func(*WsCombinedBookTickerEvent) {}, noop)
+			return err
+		},
+	}
+	for name, serve := range serves {
+		s.Run(name, func() {
+			r := s.r()
+			r.ErrorIs(serve(nil), ErrNoStreams)
+			r.ErrorIs(serve([]string{}), ErrNoStreams)
+			r.EqualError(serve([]string{"BTCUSDT", ""}), `invalid stream symbol ""`)
+			r.EqualError(serve([]string{"BTC/USDT"}), `invalid stream symbol "BTC/USDT"`)
+			r.EqualError(serve([]string{"btcusdt@depth"}), `invalid stream symbol "btcusdt@depth"`)
+		})
+	}
+}
+
 func (s *websocketServiceTestSuite) assertWsPartialDepthEventEqual(e, a *WsPartialDepthEvent) {
 	r := s.r()
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
@@ -573,6 +817,29 @@ func (s *websocketServiceTestSuite) assertWsKlineEventEqual(e, a *WsKlineEvent)
 	r.Equal(ek.ActiveBuyQuoteVolume, ak.ActiveBuyQuoteVolume, "ActiveBuyQuoteVolume")
 }
 
+func (s *websocketServiceTestSuite) TestWsAggTradeServeFanOut() {
+	data := []byte(`{"e": "aggTrade", "E": 1499405254326, "s": "ETHBTC", "a": 70232, "p": "0.10281118", "q": "8.15632997"}`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	fanOut := new(common.WsFanOut[*WsAggTradeEvent])
+	var strategy, recorder []int64
+	fanOut.Add(func(event *WsAggTradeEvent) {
+		strategy = append(strategy, event.AggTradeID)
+	})
+	fanOut.Add(func(event *WsAggTradeEvent) {
+		recorder = append(recorder, event.AggTradeID)
+	})
+	doneC, stopC, err := WsAggTradeServe("ETHBTC", fanOut.Handle, func(err error) {
+		s.r().NoError(err)
+	})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+	s.r().Equal([]int64{70232}, strategy)
+	s.r().Equal([]int64{70232}, recorder)
+}
+
 func (s *websocketServiceTestSuite) TestWsAggTradeServe() {
 	data := []byte(`{
         "e": "aggTrade",
@@ -723,6 +990,112 @@ func (s *websocketServiceTestSuite) TestWsCombinedAggTradeServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestWsCombinedAggTradeServeDispatch() {
+	messages := [][]byte{
+		[]byte(`{"stream":"ethbtc@aggTrade","data":{"e":"aggTrade","E":1499405254326,"s":"ETHBTC","a":70232,"p":"0.10281118","q":"8.15632997"}}`),
+		[]byte(`{"stream":"bnbbtc@aggTrade","data":{"e":"aggTrade","E":1499405254327,"s":"BNBBTC","a":70233,"p":"0.00281118","q":"1.00000000"}}`),
+		[]byte(`{"stream":"ethbtc@aggTrade","data":{"e":"aggTrade","E":1499405254328,"s":"ETHBTC","a":70234,"p":"0.10281119","q":"2.00000000"}}`),
+	}
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		for _, message := range messages {
+			handler(message)
+		}
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+
+	var ethIDs, bnbIDs []int64
+	doneC, stopC, err := WsCombinedAggTradeServeDispatch(map[string]WsAggTradeHandler{
+		"ETHBTC": func(event *WsAggTradeEvent) {
+			ethIDs = append(ethIDs, event.AggTradeID)
+		},
+		"bnbbtc": func(event *WsAggTradeEvent) {
+			bnbIDs = append(bnbIDs, event.AggTradeID)
+		},
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+	r := s.r()
+	r.NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	r.Equal(getCombinedEndpoint()+"bnbbtc@aggTrade/ethbtc@aggTrade", endpoint)
+	r.Equal([]int64{70232, 70234}, ethIDs)
+	r.Equal([]int64{70233}, bnbIDs)
+}
+
+func (s *websocketServiceTestSuite) TestWsCombinedAggTradeServeDispatchNoHandler() {
+	_, _, err := WsCombinedAggTradeServeDispatch(nil, func(err error) {})
+	s.r().Error(err)
+}
+
+func (s *websocketServiceTestSuite) TestWsAggTradeServe100Ms() {
+	data := []byte(`{
+		"stream":"ethbtc@aggTrade@100ms",
+		"data": {
+			"e": "aggTrade",
+			"E": 1499405254326,
+			"s": "ETHBTC",
+			"a": 70232,
+			"p": "0.10281118",
+			"q": "8.15632997",
+			"f": 77489,
+			"l": 77489,
+			"T": 1499405254324,
+			"m": true,
+			"M": true
+		}
+	}`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe(2)
+	mock := wsServe
+	var endpoints []string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		return mock(cfg, handler, errHandler)
+	}
+
+	e := &WsAggTradeEvent{
+		Event:                 "aggTrade",
+		Time:                  1499405254326,
+		Symbol:                "ETHBTC",
+		AggTradeID:            70232,
+		Price:                 "0.10281118",
+		Quantity:              "8.15632997",
+		FirstBreakdownTradeID: 77489,
+		LastBreakdownTradeID:  77489,
+		TradeTime:             1499405254324,
+		IsBuyerMaker:          true,
+	}
+	errHandler := func(err error) {
+		s.r().NoError(err)
+	}
+	doneC, stopC, err := WsCombinedAggTradeServe100Ms([]string{"ETHBTC", "BNBBTC"}, func(event *WsAggTradeEvent) {
+		s.assertWsAggTradeEventEqual(e, event)
+	}, errHandler)
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	doneC, stopC, err = WsAggTradeServe100Ms("ETHBTC", func(event *WsAggTradeEvent) {}, errHandler)
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	s.r().Equal([]string{
+		getCombinedEndpoint() + "ethbtc@aggTrade@100ms/bnbbtc@aggTrade@100ms",
+		getWsEndpoint() + "/ethbtc@aggTrade@100ms",
+	}, endpoints)
+}
+
 func (s *websocketServiceTestSuite) assertWsAggTradeEventEqual(e, a *WsAggTradeEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -795,6 +1168,188 @@ func (s *websocketServiceTestSuite) testWsUserDataServe(data []byte, expectedEve
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestWsSpotUserDataServe() {
+	messages := [][]byte{
+		[]byte(`{
+			"e": "outboundAccountPosition",
+			"E": 1564034571105,
+			"u": 1564034571073,
+			"B": [
+				{"a": "ETH", "f": "10000.000000", "l": "0.000000"}
+			]
+		}`),
+		[]byte(`{
+			"e": "balanceUpdate",
+			"E": 1573200697110,
+			"a": "BTC",
+			"d": "100.00000000",
+			"T": 1573200697068
+		}`),
+		[]byte(`{
+			"e": "executionReport",
+			"E": 1499405658658,
+			"s": "ETHBTC",
+			"c": "mUvoqJxFIILMdfAW5iGSOW",
+			"S": "BUY",
+			"o": "LIMIT",
+			"f": "GTC",
+			"q": "1.00000000",
+			"p": "0.10264410",
+			"P": "0.00000000",
+			"F": "0.50000000",
+			"g": -1,
+			"C": "",
+			"x": "NEW",
+			"X": "NEW",
+			"r": "NONE",
+			"i": 4293153,
+			"l": "0.00000000",
+			"z": "0.00000000",
+			"L": "0.00000000",
+			"n": "0",
+			"N": null,
+			"T": 1499405658657,
+			"t": -1,
+			"v": 3,
+			"I": 8641984,
+			"w": true,
+			"m": false,
+			"M": true,
+			"O": 1499405658657,
+			"Z": "0.00000000",
+			"Y": "0.00000000",
+			"Q": "0.00000000",
+			"W": 1499405658657,
+			"V": "EXPIRE_MAKER"
+		}`),
+		[]byte(`{"e": "listStatus", "E": 1564035303637, "s": "ETHBTC", "g": 2}`),
+	}
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		for _, message := range messages {
+			handler(message)
+		}
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+
+	var events []*WsSpotUserDataEvent
+	var raw [][]byte
+	doneC, stopC, err := WsSpotUserDataServe("listenKey", func(event *WsSpotUserDataEvent) {
+		events = append(events, event)
+	}, func(message []byte) {
+		raw = append(raw, message)
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+	r := s.r()
+	r.NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	r.Equal(getWsEndpoint()+"/listenKey", endpoint)
+	r.Len(events, 3)
+	r.Equal(&WsSpotUserDataEvent{
+		Event: UserDataEventTypeOutboundAccountPosition,
+		Time:  1564034571105,
+		AccountPosition: &WsSpotAccountPosition{
+			LastUpdateTime: 1564034571073,
+			Balances:       []WsSpotBalance{{Asset: "ETH", Free: "10000.000000", Locked: "0.000000"}},
+		},
+	}, events[0])
+	r.Equal(&WsSpotUserDataEvent{
+		Event:         UserDataEventTypeBalanceUpdate,
+		Time:          1573200697110,
+		BalanceUpdate: &WsSpotBalanceUpdate{Asset: "BTC", Delta: "100.00000000", ClearTime: 1573200697068},
+	}, events[1])
+	r.Equal(UserDataEventTypeExecutionReport, events[2].Event)
+	r.Equal(&WsSpotExecutionReport{
+		Symbol:                   "ETHBTC",
+		ClientOrderID:            "mUvoqJxFIILMdfAW5iGSOW",
+		Side:                     SideTypeBuy,
+		Type:                     OrderTypeLimit,
+		TimeInForce:              TimeInForceTypeGTC,
+		Quantity:                 "1.00000000",
+		Price:                    "0.10264410",
+		StopPrice:                "0.00000000",
+		IcebergQuantity:          "0.50000000",
+		OrderListID:              -1,
+		ExecutionType:            "NEW",
+		Status:                   OrderStatusTypeNew,
+		RejectReason:             "NONE",
+		OrderID:                  4293153,
+		LastExecutedQuantity:     "0.00000000",
+		CumulativeFilledQuantity: "0.00000000",
+		LastExecutedPrice:        "0.00000000",
+		Commission:               "0",
+		TransactionTime:          1499405658657,
+		TradeID:                  -1,
+		IsWorking:                true,
+		CreationTime:             1499405658657,
+		CumulativeQuoteQuantity:  "0.00000000",
+		LastQuoteQuantity:        "0.00000000",
+		QuoteOrderQuantity:       "0.00000000",
+		WorkingTime:              1499405658657,
+		SelfTradePreventionMode:  STPModeExpireMaker,
+		PreventedMatchID:         3,
+	}, events[2].ExecutionReport)
+	r.Len(raw, 1)
+	r.Contains(string(raw[0]), "listStatus")
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataServeWithUnknownHandler() {
+	messages := [][]byte{
+		[]byte(`{"e": "listenKeyExpired", "E": 1699596037418, "listenKey": "listenKey"}`),
+		[]byte(`{"e": "someNewEvent", "E": 1699596037419, "x": "y"}`),
+		// the fields of unknown events can clash with the ones of known events
+		[]byte(`{"e": "externalLockUpdate", "E": 1699596037420, "a": "NEO", "d": "10.00000000", "T": 1581101996000}`),
+		[]byte(`{"e": "someNewEvent", "E": 1699596037421, "o": "x"}`),
+	}
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		for _, message := range messages {
+			handler(message)
+		}
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+
+	var known, unknown []*WsUserDataEvent
+	doneC, stopC, err := WsUserDataServeWithUnknownHandler("listenKey", func(event *WsUserDataEvent) {
+		known = append(known, event)
+	}, func(event *WsUserDataEvent) {
+		unknown = append(unknown, event)
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+	r := s.r()
+	r.NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+
+	r.Len(known, 1)
+	r.Equal(UserDataEventTypeListenKeyExpired, known[0].Event)
+	r.Equal(int64(1699596037418), known[0].Time)
+	r.JSONEq(string(messages[0]), string(known[0].RawMessage))
+	r.Len(unknown, 3)
+	r.Equal(UserDataEventType("someNewEvent"), unknown[0].Event)
+	r.JSONEq(string(messages[1]), string(unknown[0].RawMessage))
+	r.Equal(UserDataEventTypeExternalLockUpdate, unknown[1].Event)
+	r.Equal(int64(1699596037420), unknown[1].Time)
+	r.JSONEq(string(messages[2]), string(unknown[1].RawMessage))
+	r.JSONEq(string(messages[3]), string(unknown[2].RawMessage))
+}
+
 func (s *websocketServiceTestSuite) TestWsUserDataServeAccountUpdate() {
 	data := []byte(`{
 	   "e":"outboundAccountPosition",
@@ -957,6 +1512,31 @@ func (s *websocketServiceTestSuite) TestWsMarketStatServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestWsMarketStatEventFloat64() {
+	r := s.r()
+	event := &WsMarketStatEvent{PriceChange: "0.0015", PriceChangePercent: "250.00"}
+	priceChange, err := event.PriceChangeFloat64()
+	r.NoError(err)
+	r.Equal(0.0015, priceChange)
+	percent, err := event.PriceChangePercentFloat64()
+	r.NoError(err)
+	r.Equal(250.0, percent)
+
+	// the values parsed on decoding are not stale when a field is changed
+	r.NoError(json.Unmarshal([]byte(`{"s":"BNBBTC","p":"0.0015","P":"250.00"}`), event))
+	r.Equal("BNBBTC", event.Symbol)
+	priceChange, err = event.PriceChangeFloat64()
+	r.NoError(err)
+	r.Equal(0.0015, priceChange)
+	event.PriceChange = "1"
+	priceChange, err = event.PriceChangeFloat64()
+	r.NoError(err)
+	r.Equal(1.0, priceChange)
+
+	_, err = (&WsMarketStatEvent{PriceChangePercent: "invalid"}).PriceChangePercentFloat64()
+	r.Error(err)
+}
+
 func (s *websocketServiceTestSuite) TestWsCombinedMarketStatServe() {
 	data := []byte(`{
 	"stream":"bnbbtc@ticker",
@@ -1281,6 +1861,40 @@ func (s *websocketServiceTestSuite) TestWsCombinedTradeServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestWsAllMiniMarketsStatServe100Ms() {
+	data := []byte(`[{
+		"e": "24hrMiniTicker",
+		"E": 1523658017154,
+		"s": "BNBBTC",
+		"c": "0.00175640",
+		"o": "0.00161200",
+		"h": "0.00176000",
+		"l": "0.00159370",
+		"v": "3479863.89000000",
+		"q": "5725.90587704"
+	}]`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+	mock := wsServe
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		return mock(cfg, handler, errHandler)
+	}
+
+	doneC, stopC, err := WsAllMiniMarketsStatServe100Ms(func(event WsAllMiniMarketsStatEvent) {
+		s.r().Len(event, 1)
+		s.r().Equal("BNBBTC", event[0].Symbol)
+		s.r().Equal("0.00175640", event[0].LastPrice)
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+	s.r().Equal(getWsEndpoint()+"/!miniTicker@arr@100ms", endpoint)
+}
+
 func (s *websocketServiceTestSuite) TestWsAllMiniMarketsStatServe() {
 	data := []byte(`[{
   		"e": "24hrMiniTicker",
@@ -1428,6 +2042,40 @@ func (s *websocketServiceTestSuite) TestAllBookTickerServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestWsCombinedBookTickerServeWithStream() {
+	data := []byte(`{
+		"stream": "bnbusdt@bookTicker",
+		"data": {
+			"u": 400900217,
+			"s": "BNBUSDT",
+			"b": "25.35190000",
+			"B": "31.21000000",
+			"a": "25.36520000",
+			"A": "40.66000000"
+		}
+	}`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsCombinedBookTickerServeWithStream([]string{"BNBUSDT"}, func(event *WsCombinedBookTickerEvent) {
+		s.r().Equal("bnbusdt@bookTicker", event.Stream, "Stream")
+		s.assertWsBookTickerEvent(&WsBookTickerEvent{
+			UpdateID:     400900217,
+			Symbol:       "BNBUSDT",
+			BestBidPrice: "25.35190000",
+			BestBidQty:   "31.21000000",
+			BestAskPrice: "25.36520000",
+			BestAskQty:   "40.66000000",
+		}, event.Data)
+	}, func(err error) {
+		s.r().EqualError(err, fakeErrMsg)
+	})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) assertWsBookTickerEvent(e, a *WsBookTickerEvent) {
 	r := s.r()
 	r.Equal(e.UpdateID, a.UpdateID, "UpdateID")
@@ -1437,3 +2085,66 @@ func (s *websocketServiceTestSuite) assertWsBookTickerEvent(e, a *WsBookTickerEv
 	r.Equal(e.BestAskPrice, a.BestAskPrice, "BestAskPrice")
 	r.Equal(e.BestAskQty, a.BestAskQty, "BestAskQty")
 }
+
+func (s *websocketServiceTestSuite) TestWsRawServe() {
+	var endpoints []string
+	messages := [][]byte{
+		[]byte(`{"e":"avgPrice","s":"BTCUSDT","w":"5m","p":"9643.5"}`),
+	}
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		for _, message := range messages {
+			handler(message)
+		}
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	r := s.r()
+
+	var received [][]byte
+	_, _, err := WsRawServe("btcusdt@avgPrice", func(message []byte) {
+		received = append(received, message)
+	}, func(err error) { r.FailNow(err.Error()) })
+	r.NoError(err)
+	r.Equal([]string{getWsEndpoint() + "/btcusdt@avgPrice"}, endpoints)
+	r.Equal(messages, received)
+
+	_, _, err = WsRawServe("", func(message []byte) {}, func(err error) {})
+	r.ErrorIs(err, ErrNoStreams)
+	r.Len(endpoints, 1)
+}
+
+func (s *websocketServiceTestSuite) TestWsRawCombinedServe() {
+	var endpoints []string
+	messages := [][]byte{
+		[]byte(`{"stream":"btcusdt@avgPrice","data":{"e":"avgPrice","s":"BTCUSDT"}}`),
+		[]byte(`{"stream":"ethusdt@avgPrice","data":{"e":"avgPrice","s":"ETHUSDT"}}`),
+		[]byte(`not json`),
+	}
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		for _, message := range messages {
+			handler(message)
+		}
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	r := s.r()
+
+	var streams []string
+	var data []string
+	var errs []error
+	_, _, err := WsRawCombinedServe([]string{"btcusdt@avgPrice", "ethusdt@avgPrice"}, func(stream string, message []byte) {
+		streams = append(streams, stream)
+		data = append(data, string(message))
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	r.NoError(err)
+	r.Equal([]string{getCombinedEndpoint() + "btcusdt@avgPrice/ethusdt@avgPrice"}, endpoints)
+	r.Equal([]string{"btcusdt@avgPrice", "ethusdt@avgPrice"}, streams)
+	r.Equal([]string{`{"e":"avgPrice","s":"BTCUSDT"}`, `{"e":"avgPrice","s":"ETHUSDT"}`}, data)
+	r.Len(errs, 1)
+
+	_, _, err = WsRawCombinedServe(nil, func(stream string, message []byte) {}, func(err error) {})
+	r.ErrorIs(err, ErrNoStreams)
+	r.Len(endpoints, 1)
+}
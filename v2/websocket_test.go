@@ -0,0 +1,227 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Bot-Hive-Trading/go-binance/v2/common"
+)
+
+func TestWsServeCloseError(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      int
+		text      string
+		retryable bool
+	}{
+		{"normal closure", websocket.CloseNormalClosure, "24h rotation", true},
+		{"policy violation", websocket.ClosePolicyViolation, "too many streams", false},
+		{"service restart", websocket.CloseServiceRestart, "", true},
+		{"abnormal closure", websocket.CloseAbnormalClosure, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			upgrader := websocket.Upgrader{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				c, err := upgrader.Upgrade(w, req, nil)
+				if err != nil {
+					return
+				}
+				defer c.Close()
+				c.WriteMessage(websocket.TextMessage, []byte(`{}`))
+				if tt.code == websocket.CloseAbnormalClosure {
+					// drop the connection without a close frame
+					return
+				}
+				c.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(tt.code, tt.text), time.Now().Add(time.Second))
+				c.ReadMessage()
+			}))
+			defer server.Close()
+
+			var messages int
+			var serveErr error
+			endpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+			doneC, _, err := wsServe(newWsConfig(endpoint), func(message []byte) {
+				messages++
+			}, func(err error) {
+				serveErr = err
+			})
+			r.NoError(err)
+			select {
+			case <-doneC:
+			case <-time.After(5 * time.Second):
+				r.FailNow("stream not closed")
+			}
+
+			r.Equal(1, messages)
+			var closeErr *common.WsCloseError
+			r.True(errors.As(serveErr, &closeErr), "%v", serveErr)
+			r.Equal(tt.code, closeErr.Code)
+			if tt.text != "" {
+				r.Equal(tt.text, closeErr.Text)
+			}
+			r.Equal(tt.retryable, closeErr.Retryable())
+			var libErr *websocket.CloseError
+			r.True(errors.As(serveErr, &libErr))
+		})
+	}
+}
+
+func TestWsServeMaxMessageSize(t *testing.T) {
+	r := require.New(t)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		c, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		c.WriteMessage(websocket.TextMessage, []byte(strings.Repeat("a", 1000)))
+		c.WriteMessage(websocket.TextMessage, []byte(strings.Repeat("b", 2000)))
+		c.ReadMessage()
+	}))
+	defer server.Close()
+
+	var messages int
+	var serveErr error
+	endpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+	var received common.WsByteCounter
+	cfg := newWsConfig(endpoint, WithMaxMessageSize(1024), WithBytesReceived(&received))
+	r.Equal(int64(1024), cfg.MaxMessageSize)
+	doneC, _, err := wsServe(cfg, func(message []byte) {
+		messages++
+	}, func(err error) {
+		serveErr = err
+	})
+	r.NoError(err)
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		r.FailNow("stream not closed")
+	}
+
+	r.Equal(1, messages)
+	var tooBigErr *common.WsMessageTooBigError
+	r.True(errors.As(serveErr, &tooBigErr), "%v", serveErr)
+	r.Equal(endpoint, tooBigErr.Endpoint)
+	r.Equal(int64(1024), tooBigErr.Limit)
+	r.ErrorIs(serveErr, websocket.ErrReadLimit)
+	r.Equal(uint64(1000), received.Load())
+}
+
+func TestNewWsConfigOptions(t *testing.T) {
+	r := require.New(t)
+	cfg := newWsConfig("wss://stream")
+	r.Equal(WebsocketMaxMessageSize, cfg.MaxMessageSize)
+	r.Equal(WebsocketKeepalive, cfg.Keepalive)
+	r.Equal(WebsocketTimeout, cfg.KeepaliveTimeout)
+	r.Nil(cfg.PingHandler)
+	r.Nil(cfg.PongHandler)
+
+	var handled []string
+	cfg = newWsConfig("wss://stream",
+		WithMaxMessageSize(1024),
+		WithKeepalive(!WebsocketKeepalive),
+		WithKeepaliveTimeout(time.Second),
+		WithPingHandler(func(data string) error {
+			handled = append(handled, "ping "+data)
+			return nil
+		}),
+		WithPongHandler(func(data string) error {
+			handled = append(handled, "pong "+data)
+			return nil
+		}),
+	)
+	r.Equal(int64(1024), cfg.MaxMessageSize)
+	r.Equal(!WebsocketKeepalive, cfg.Keepalive)
+	r.Equal(time.Second, cfg.KeepaliveTimeout)
+	r.NoError(cfg.PingHandler("a"))
+	r.NoError(cfg.PongHandler("b"))
+	r.Equal([]string{"ping a", "pong b"}, handled)
+}
+
+func TestWsServeKeepaliveOptions(t *testing.T) {
+	r := require.New(t)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		c, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		c.WriteControl(websocket.PingMessage, []byte("server"), time.Now().Add(time.Second))
+		// the default ping handler of the server replies to the keepalive pings
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	pings := make(chan string, 1)
+	pongs := make(chan struct{}, 1)
+	endpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+	cfg := newWsConfig(endpoint,
+		WithKeepalive(true),
+		// the first keepalive ping is sent on connect, no stall check happens during the test
+		WithKeepaliveTimeout(time.Minute),
+		WithPingHandler(func(data string) error {
+			pings <- data
+			return nil
+		}),
+		WithPongHandler(func(data string) error {
+			pongs <- struct{}{}
+			return nil
+		}),
+	)
+	doneC, stopC, err := wsServe(cfg, func(message []byte) {}, func(err error) {})
+	r.NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+
+	select {
+	case data := <-pings:
+		r.Equal("server", data)
+	case <-time.After(5 * time.Second):
+		r.FailNow("no ping handled")
+	}
+	select {
+	case <-pongs:
+	case <-time.After(5 * time.Second):
+		r.FailNow("no pong handled")
+	}
+}
+
+func TestWsTypedServeOptions(t *testing.T) {
+	r := require.New(t)
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var got *WsConfig
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		got = cfg
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	_, _, err := WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {},
+		WithKeepalive(true), WithKeepaliveTimeout(time.Second), WithMaxMessageSize(1024))
+	r.NoError(err)
+	r.NotNil(got)
+	r.True(got.Keepalive)
+	r.Equal(time.Second, got.KeepaliveTimeout)
+	r.Equal(int64(1024), got.MaxMessageSize)
+
+	_, _, err = WsDepthServe("BTCUSDT", func(event *WsDepthEvent) {}, func(err error) {})
+	r.NoError(err)
+	r.Equal(WebsocketMaxMessageSize, got.MaxMessageSize)
+}